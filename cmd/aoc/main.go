@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	_ "github.com/sontanon/aoc-2025/internal/day01"
+	_ "github.com/sontanon/aoc-2025/internal/day02"
+	_ "github.com/sontanon/aoc-2025/internal/day03"
+	_ "github.com/sontanon/aoc-2025/internal/day04"
+	_ "github.com/sontanon/aoc-2025/internal/day05"
+	_ "github.com/sontanon/aoc-2025/internal/day06"
+	_ "github.com/sontanon/aoc-2025/internal/day07"
+	_ "github.com/sontanon/aoc-2025/internal/day08"
+	_ "github.com/sontanon/aoc-2025/internal/day09"
+	_ "github.com/sontanon/aoc-2025/internal/day10"
+)
+
+const usage = `usage: aoc <command> [arguments]
+
+commands:
+  run <day|all> [--part 1|2] [--input file|-|dir] [--format text|json] [--phases] [--workers n]
+      [--cpuprofile file] [--memprofile file] [--trace file] [day flags]
+  verify <day|all> [--part 1|2] [--input file|dir] [--workers n] [day flags]
+  bench <day|all> [--count n] [--history path] [--threshold percent] [--part 1|2] [--input file|dir] [--workers n] [day flags]
+  list
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	switch os.Args[1] {
+	case "run":
+		if err := dayCommand("run", aoc.Run, os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "aoc run:", err)
+			os.Exit(1)
+		}
+	case "verify":
+		if err := dayCommand("verify", aoc.RunVerify, os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "aoc verify:", err)
+			os.Exit(1)
+		}
+	case "bench":
+		if err := dayCommand("bench", aoc.RunBench, os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "aoc bench:", err)
+			os.Exit(1)
+		}
+	case "list":
+		for _, d := range aoc.Days() {
+			fmt.Println(d.Number)
+		}
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+type commandFunc func(ctx context.Context, w io.Writer, days []aoc.Day, name string, args []string) error
+
+func dayCommand(name string, fn commandFunc, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing day\n%s", usage)
+	}
+	days, err := selectDays(args[0])
+	if err != nil {
+		return err
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return fn(ctx, os.Stdout, days, "aoc "+name+" "+args[0], args[1:])
+}
+
+func selectDays(selector string) ([]aoc.Day, error) {
+	if selector == "all" {
+		return aoc.Days(), nil
+	}
+	number, err := strconv.Atoi(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q, expected a number or all", selector)
+	}
+	d, err := aoc.Lookup(number)
+	if err != nil {
+		return nil, err
+	}
+	return []aoc.Day{d}, nil
+}
@@ -0,0 +1,245 @@
+package aoc
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const defaultHistoryPath = "bench_history.json"
+
+type BenchResult struct {
+	Day         int    `json:"day"`
+	Part        int    `json:"part"`
+	Iterations  int    `json:"iterations"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	AllocsPerOp uint64 `json:"allocs_per_op"`
+	BytesPerOp  uint64 `json:"bytes_per_op"`
+}
+
+// BenchRun holds the results measured at a commit with the flags in Args,
+// e.g. day 2's --method, as runs with different flags are not comparable.
+type BenchRun struct {
+	Commit    string        `json:"commit"`
+	Args      []string      `json:"args,omitempty"`
+	Timestamp time.Time     `json:"timestamp"`
+	Results   []BenchResult `json:"results"`
+}
+
+func (r BenchRun) find(day, part int) (BenchResult, bool) {
+	i := slices.IndexFunc(r.Results, func(br BenchResult) bool {
+		return br.Day == day && br.Part == part
+	})
+	if i == -1 {
+		return BenchResult{}, false
+	}
+	return r.Results[i], true
+}
+
+type BenchHistory struct {
+	Runs []BenchRun `json:"runs"`
+}
+
+func LoadHistory(path string) (BenchHistory, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return BenchHistory{}, nil
+	}
+	if err != nil {
+		return BenchHistory{}, err
+	}
+	var h BenchHistory
+	if err := json.Unmarshal(data, &h); err != nil {
+		return BenchHistory{}, fmt.Errorf("error parsing benchmark history %s: %w", path, err)
+	}
+	return h, nil
+}
+
+func (h BenchHistory) Save(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Latest returns the most recent result of a part recorded with args together
+// with the commit it was recorded at.
+func (h BenchHistory) Latest(day, part int, args []string) (BenchResult, string, bool) {
+	for _, run := range slices.Backward(h.Runs) {
+		if !slices.Equal(run.Args, args) {
+			continue
+		}
+		if r, ok := run.find(day, part); ok {
+			return r, run.Commit, true
+		}
+	}
+	return BenchResult{}, "", false
+}
+
+// Record adds run as the latest run. Results already recorded for the same
+// commit and args are kept unless run measured the same part again.
+func (h *BenchHistory) Record(run BenchRun) {
+	i := slices.IndexFunc(h.Runs, func(r BenchRun) bool {
+		return r.Commit == run.Commit && slices.Equal(r.Args, run.Args)
+	})
+	if i != -1 {
+		for _, r := range h.Runs[i].Results {
+			if _, ok := run.find(r.Day, r.Part); !ok {
+				run.Results = append(run.Results, r)
+			}
+		}
+		slices.SortFunc(run.Results, func(a, b BenchResult) int {
+			return cmp.Or(cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part))
+		})
+		h.Runs = slices.Delete(h.Runs, i, i+1)
+	}
+	h.Runs = append(h.Runs, run)
+}
+
+// Bench solves a part iterations times from an in-memory copy of its input,
+// checking every answer against expected.
+func Bench(ctx context.Context, number int, s Solver, part int, data []byte, iterations, expected int) (BenchResult, error) {
+	if iterations < 1 {
+		return BenchResult{}, fmt.Errorf("invalid iteration count %d", iterations)
+	}
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for range iterations {
+		answer, err := SolvePart(ctx, s, part, bytes.NewReader(data))
+		if err != nil {
+			return BenchResult{}, fmt.Errorf("day %d part %d: %w", number, part, err)
+		}
+		if answer != expected {
+			return BenchResult{}, fmt.Errorf("day %d part %d: got %d, want %d", number, part, answer, expected)
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	n := uint64(iterations)
+	return BenchResult{
+		Day:         number,
+		Part:        part,
+		Iterations:  iterations,
+		NsPerOp:     elapsed.Nanoseconds() / int64(iterations),
+		AllocsPerOp: (after.Mallocs - before.Mallocs) / n,
+		BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / n,
+	}, nil
+}
+
+// RunBench benchmarks the parts of days with a known answer, prints them
+// against the previous results and records them in the history file under the
+// current commit.
+func RunBench(ctx context.Context, w io.Writer, days []Day, name string, args []string) error {
+	f := newDayFlags(name, days)
+	count := f.fs.Int("count", 10, "iterations per part")
+	historyPath := f.fs.String("history", defaultHistoryPath, "benchmark history file")
+	threshold := f.fs.Float64("threshold", 10, "slowdown in percent reported as a regression")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+
+	run := BenchRun{Commit: gitCommit(), Args: f.args("count", "history", "threshold", "part"), Timestamp: time.Now().UTC()}
+	var skipped []string
+	for i, d := range days {
+		answers, err := LoadAnswers(f.inputSpec, d.Number)
+		if err != nil {
+			return err
+		}
+		input, err := OpenInput(f.inputSpec, d.Number)
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(input)
+		input.Close()
+		if err != nil {
+			return err
+		}
+		for _, part := range f.parts() {
+			expected, ok := answers[part]
+			if !ok {
+				skipped = append(skipped, fmt.Sprintf("day %d part %d", d.Number, part))
+				continue
+			}
+			result, err := Bench(ctx, d.Number, f.solvers[i], part, data, *count, expected)
+			if err != nil {
+				return err
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+
+	history, err := LoadHistory(*historyPath)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "commit %s\n", run.Commit)
+	if len(run.Args) > 0 {
+		fmt.Fprintf(w, "args %s\n", strings.Join(run.Args, " "))
+	}
+	if err := writeComparison(w, run, history, *threshold); err != nil {
+		return err
+	}
+	history.Record(run)
+	if err := history.Save(*historyPath); err != nil {
+		return err
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(w, "skipped without a known answer: %s\n", strings.Join(skipped, ", "))
+	}
+	return nil
+}
+
+// writeComparison prints the results of run next to the latest earlier result
+// of each part found in history.
+func writeComparison(w io.Writer, run BenchRun, history BenchHistory, threshold float64) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tNS/OP\tALLOCS/OP\tB/OP\tBASELINE\tBASE NS/OP\tBASE ALLOCS/OP\tDELTA\t")
+	regressions := 0
+	for _, r := range run.Results {
+		baseline, baseNs, baseAllocs, delta, status := "-", "-", "-", "-", ""
+		if p, commit, ok := history.Latest(r.Day, r.Part, run.Args); ok && p.NsPerOp > 0 {
+			change := 100 * float64(r.NsPerOp-p.NsPerOp) / float64(p.NsPerOp)
+			baseline = commit
+			baseNs = strconv.FormatInt(p.NsPerOp, 10)
+			baseAllocs = strconv.FormatUint(p.AllocsPerOp, 10)
+			delta = fmt.Sprintf("%+.1f%%", change)
+			if change > threshold {
+				status = "regression"
+				regressions++
+			}
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			r.Day, r.Part, r.NsPerOp, r.AllocsPerOp, r.BytesPerOp, baseline, baseNs, baseAllocs, delta, status)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if regressions > 0 {
+		fmt.Fprintf(w, "%d parts slowed down by more than %.1f%%\n", regressions, threshold)
+	}
+	return nil
+}
+
+func gitCommit() string {
+	out, err := exec.Command("git", "describe", "--always", "--dirty").Output()
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,107 @@
+package aoc
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBench(t *testing.T) {
+	tests := []struct {
+		name          string
+		part          int
+		expected      int
+		errorExpected bool
+	}{
+		{"Correct answer", 1, 2, false},
+		{"Wrong answer", 1, 3, true},
+		{"Solver error", 2, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Bench(t.Context(), 1, lineCountSolver{}, tt.part, []byte("a\nb\n"), 3, tt.expected)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("Bench() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if err == nil && (result.Day != 1 || result.Part != tt.part || result.Iterations != 3) {
+				t.Errorf("Bench() = %+v", result)
+			}
+		})
+	}
+}
+
+func TestBenchHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.json")
+	history, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	if _, _, ok := history.Latest(1, 1, nil); ok {
+		t.Fatalf("Latest() found a result in an empty history")
+	}
+
+	history.Record(BenchRun{Commit: "aaa", Results: []BenchResult{{Day: 1, Part: 1, NsPerOp: 100}, {Day: 1, Part: 2, NsPerOp: 200}}})
+	history.Record(BenchRun{Commit: "bbb", Results: []BenchResult{{Day: 1, Part: 1, NsPerOp: 150}}})
+	history.Record(BenchRun{Commit: "bbb", Results: []BenchResult{{Day: 2, Part: 1, NsPerOp: 50}}})
+	history.Record(BenchRun{Commit: "bbb", Args: []string{"--method=formula"}, Results: []BenchResult{{Day: 2, Part: 1, NsPerOp: 5}}})
+	if err := history.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, history) {
+		t.Fatalf("LoadHistory() = %+v, want %+v", loaded, history)
+	}
+
+	tests := []struct {
+		day, part int
+		args      []string
+		ns        int64
+		commit    string
+	}{
+		{1, 1, nil, 150, "bbb"},
+		{1, 2, nil, 200, "aaa"},
+		{2, 1, nil, 50, "bbb"},
+		{2, 1, []string{"--method=formula"}, 5, "bbb"},
+	}
+	for _, tt := range tests {
+		result, commit, ok := loaded.Latest(tt.day, tt.part, tt.args)
+		if !ok || result.NsPerOp != tt.ns || commit != tt.commit {
+			t.Errorf("Latest(%d, %d, %v) = %v, %q, %v, want %d ns at %q", tt.day, tt.part, tt.args, result.NsPerOp, commit, ok, tt.ns, tt.commit)
+		}
+	}
+	if _, _, ok := loaded.Latest(1, 1, []string{"--method=formula"}); ok {
+		t.Errorf("Latest() found a result recorded with other args")
+	}
+	if len(loaded.Runs) != 3 {
+		t.Errorf("len(Runs) = %d, want 3", len(loaded.Runs))
+	}
+}
+
+func TestWriteComparison(t *testing.T) {
+	history := BenchHistory{Runs: []BenchRun{{Commit: "aaa", Results: []BenchResult{{Day: 1, Part: 1, NsPerOp: 100}, {Day: 1, Part: 2, NsPerOp: 100}}}}}
+	run := BenchRun{Commit: "bbb", Results: []BenchResult{{Day: 1, Part: 1, NsPerOp: 105}, {Day: 1, Part: 2, NsPerOp: 150}, {Day: 2, Part: 1, NsPerOp: 10}}}
+
+	var sb strings.Builder
+	if err := writeComparison(&sb, run, history, 10); err != nil {
+		t.Fatalf("writeComparison() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("writeComparison() printed %d lines, want 5:\n%s", len(lines), sb.String())
+	}
+	for i, want := range []string{"+5.0%", "+50.0%", "-"} {
+		if !strings.Contains(lines[i+1], want) {
+			t.Errorf("row %d = %q, want it to contain %q", i+1, lines[i+1], want)
+		}
+	}
+	if strings.Contains(lines[1], "regression") || !strings.Contains(lines[2], "regression") {
+		t.Errorf("regressions flagged incorrectly:\n%s", sb.String())
+	}
+	if lines[4] != "1 parts slowed down by more than 10.0%" {
+		t.Errorf("summary = %q", lines[4])
+	}
+}
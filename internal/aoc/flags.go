@@ -0,0 +1,74 @@
+package aoc
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+)
+
+// dayFlags holds the flags shared by every command running a set of days,
+// together with the solvers whose own flags were registered on the same set.
+type dayFlags struct {
+	fs        *flag.FlagSet
+	part      int
+	inputSpec string
+	workers   int
+	days      []Day
+	solvers   []Solver
+}
+
+func newDayFlags(name string, days []Day) *dayFlags {
+	f := &dayFlags{fs: flag.NewFlagSet(name, flag.ExitOnError), days: days}
+	f.fs.IntVar(&f.part, "part", 0, "part to run: 1|2, or 0 for both")
+	f.fs.StringVar(&f.inputSpec, "input", "", "input file, - for stdin, or directory of <day>/input.txt files, defaults to $"+InputDirEnv+" or ./cmd")
+	f.fs.IntVar(&f.workers, "workers", 0, "workers for parallel solvers, 0 for GOMAXPROCS")
+	f.solvers = make([]Solver, len(days))
+	for i, d := range days {
+		f.solvers[i] = d.NewSolver()
+		if r, ok := f.solvers[i].(FlagRegisterer); ok {
+			r.RegisterFlags(f.fs)
+		}
+	}
+	return f
+}
+
+func (f *dayFlags) parse(args []string) error {
+	if err := f.fs.Parse(args); err != nil {
+		return err
+	}
+	if f.fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", f.fs.Args())
+	}
+	if f.part < 0 || f.part > 2 {
+		return fmt.Errorf("invalid part %d, expected 1, 2 or 0 for both", f.part)
+	}
+	if f.inputSpec != "" && len(f.days) > 1 && !isDir(f.inputSpec) {
+		return errors.New("multiple days require a directory of inputs")
+	}
+	for _, s := range f.solvers {
+		if ws, ok := s.(WorkerSetter); ok {
+			ws.SetWorkers(f.workers)
+		}
+	}
+	return nil
+}
+
+// args returns the flags set on the command line other than those named in
+// ignored, as --name=value sorted by name.
+func (f *dayFlags) args(ignored ...string) []string {
+	var args []string
+	f.fs.Visit(func(fl *flag.Flag) {
+		if !slices.Contains(ignored, fl.Name) {
+			args = append(args, "--"+fl.Name+"="+fl.Value.String())
+		}
+	})
+	return args
+}
+
+func (f *dayFlags) parts() []int {
+	if f.part != 0 {
+		return []int{f.part}
+	}
+	return []int{1, 2}
+}
@@ -0,0 +1,90 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	InputDirEnv     = "AOC_INPUT_DIR"
+	StdinInput      = "-"
+	defaultInputDir = "cmd"
+	inputFileName   = "input.txt"
+)
+
+// InputPath resolves spec to the input file of a day. spec may be a file or a
+// directory laid out as <dir>/<day>/input.txt. An empty spec falls back to
+// $AOC_INPUT_DIR and then to ./cmd, i.e. running from the repository root.
+func InputPath(spec string, day int) (string, error) {
+	if spec == "" {
+		spec = os.Getenv(InputDirEnv)
+	}
+	if spec == "" {
+		spec = defaultInputDir
+	}
+	info, err := os.Stat(spec)
+	if err != nil {
+		return "", fmt.Errorf("error resolving input for day %d: %w", day, err)
+	}
+	if !info.IsDir() {
+		return spec, nil
+	}
+	return filepath.Join(spec, strconv.Itoa(day), inputFileName), nil
+}
+
+// OpenInput opens the input of a day as resolved by InputPath, or stdin when
+// spec is "-". Stdin is spooled to a temporary file so that every part can
+// rewind it without holding it in memory.
+func OpenInput(spec string, day int) (io.ReadSeekCloser, error) {
+	if spec == StdinInput {
+		return spool(os.Stdin)
+	}
+	path, err := InputPath(spec, day)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// OpenStream is OpenInput for reading the input once, stdin being handed over
+// as it is.
+func OpenStream(spec string, day int) (io.ReadCloser, error) {
+	if spec == StdinInput {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return OpenInput(spec, day)
+}
+
+// spool copies r to a temporary file, removed on Close, positioned at its
+// start.
+func spool(r io.Reader) (io.ReadSeekCloser, error) {
+	f, err := os.CreateTemp("", "aoc-input-*")
+	if err != nil {
+		return nil, fmt.Errorf("error spooling stdin: %w", err)
+	}
+	spooled := tempFile{f}
+	if _, err := io.Copy(f, r); err != nil {
+		return nil, errors.Join(fmt.Errorf("error reading stdin: %w", err), spooled.Close())
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Join(err, spooled.Close())
+	}
+	return spooled, nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+type tempFile struct {
+	*os.File
+}
+
+func (f tempFile) Close() error {
+	return errors.Join(f.File.Close(), os.Remove(f.Name()))
+}
@@ -0,0 +1,74 @@
+package aoc
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInputPath(t *testing.T) {
+	dir := t.TempDir()
+	dayDir := filepath.Join(dir, "7")
+	if err := os.Mkdir(dayDir, 0o755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	dayInput := filepath.Join(dayDir, "input.txt")
+	if err := os.WriteFile(dayInput, []byte("input"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		spec          string
+		envDir        string
+		expected      string
+		errorExpected bool
+	}{
+		{"File", dayInput, "", dayInput, false},
+		{"Directory", dir, "", dayInput, false},
+		{"Environment", "", dir, dayInput, false},
+		{"Flag overrides environment", dayInput, t.TempDir(), dayInput, false},
+		{"Missing file", filepath.Join(dir, "missing.txt"), "", "", true},
+		{"Missing default directory", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(InputDirEnv, tt.envDir)
+			result, err := InputPath(tt.spec, 7)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("InputPath() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if result != tt.expected {
+				t.Errorf("InputPath() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpool(t *testing.T) {
+	input, err := spool(strings.NewReader("123\n456\n"))
+	if err != nil {
+		t.Fatalf("spool() error = %v", err)
+	}
+	for range 2 {
+		if _, err := input.Seek(0, io.SeekStart); err != nil {
+			t.Fatalf("Seek() error = %v", err)
+		}
+		data, err := io.ReadAll(input)
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+		if string(data) != "123\n456\n" {
+			t.Errorf("ReadAll() = %q, want %q", data, "123\n456\n")
+		}
+	}
+	name := input.(tempFile).Name()
+	if err := input.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Errorf("Close() left %s behind, stat error = %v", name, err)
+	}
+}
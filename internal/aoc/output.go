@@ -0,0 +1,112 @@
+package aoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+type Result struct {
+	Day     int           `json:"day"`
+	Part    int           `json:"part"`
+	Answer  int           `json:"answer"`
+	Elapsed time.Duration `json:"elapsed_ns"`
+	Phases  []PhaseTiming `json:"phases,omitempty"`
+	// Details are figures worked out along with the answer, see Detailer.
+	Details map[string]int `json:"details,omitempty"`
+	// BigAnswer replaces Answer when set, for answers that do not fit in an
+	// int.
+	BigAnswer *big.Int `json:"-"`
+}
+
+func (r Result) answer() any {
+	if r.BigAnswer != nil {
+		return r.BigAnswer
+	}
+	return r.Answer
+}
+
+// details formats Details as " name=value" pairs ordered by name.
+func (r Result) details() string {
+	var b strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(r.Details)) {
+		fmt.Fprintf(&b, " %s=%d", name, r.Details[name])
+	}
+	return b.String()
+}
+
+type ResultWriter interface {
+	WriteResult(r Result) error
+}
+
+// NewResultWriter returns a writer for format. Text output groups results
+// under a "Day N" header when withDayHeaders is set and follows answers with
+// their timings when withPhases is set, JSON output emits one record per line.
+func NewResultWriter(w io.Writer, format string, withDayHeaders, withPhases bool) (ResultWriter, error) {
+	switch format {
+	case FormatText:
+		return &textWriter{w: w, withDayHeaders: withDayHeaders, withPhases: withPhases}, nil
+	case FormatJSON:
+		return jsonWriter{json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid output format %q, expected %s|%s", format, FormatText, FormatJSON)
+	}
+}
+
+type textWriter struct {
+	w              io.Writer
+	withDayHeaders bool
+	withPhases     bool
+	lastDay        int
+}
+
+func (t *textWriter) WriteResult(r Result) error {
+	if t.withDayHeaders && r.Day != t.lastDay {
+		if _, err := fmt.Fprintf(t.w, "Day %d\n", r.Day); err != nil {
+			return err
+		}
+		t.lastDay = r.Day
+	}
+	if !t.withPhases {
+		_, err := fmt.Fprintf(t.w, "Part %d: %d%s\n", r.Part, r.answer(), r.details())
+		return err
+	}
+	timings := r.Elapsed.String()
+	if len(r.Phases) > 0 {
+		phases := make([]string, len(r.Phases))
+		for i, p := range r.Phases {
+			phases[i] = p.Name + " " + p.Elapsed.String()
+		}
+		timings += ": " + strings.Join(phases, ", ")
+	}
+	_, err := fmt.Fprintf(t.w, "Part %d: %d%s (%s)\n", r.Part, r.answer(), r.details(), timings)
+	return err
+}
+
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (j jsonWriter) WriteResult(r Result) error {
+	if r.BigAnswer == nil {
+		return j.enc.Encode(r)
+	}
+	return j.enc.Encode(struct {
+		Day     int            `json:"day"`
+		Part    int            `json:"part"`
+		Answer  *big.Int       `json:"answer"`
+		Elapsed time.Duration  `json:"elapsed_ns"`
+		Phases  []PhaseTiming  `json:"phases,omitempty"`
+		Details map[string]int `json:"details,omitempty"`
+	}{r.Day, r.Part, r.BigAnswer, r.Elapsed, r.Phases, r.Details})
+}
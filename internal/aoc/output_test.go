@@ -0,0 +1,90 @@
+package aoc
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestResultWriter(t *testing.T) {
+	results := []Result{
+		{Day: 7, Part: 1, Answer: 21, Elapsed: 1500 * time.Nanosecond, Phases: []PhaseTiming{{PhaseParse, 1000 * time.Nanosecond}, {PhaseSolve, 500 * time.Nanosecond}}},
+		{Day: 7, Part: 2, Answer: 40, Elapsed: 2500 * time.Nanosecond, Details: map[string]int{"raw_total": 45, "merged_spans": 3}},
+		{Day: 8, Part: 1, Answer: 40, Elapsed: 10 * time.Nanosecond},
+		{Day: 8, Part: 2, BigAnswer: new(big.Int).Lsh(big.NewInt(1), 70), Elapsed: 20 * time.Nanosecond},
+	}
+	tests := []struct {
+		name           string
+		format         string
+		withDayHeaders bool
+		withPhases     bool
+		expected       string
+		errorExpected  bool
+	}{
+		{
+			"Text",
+			FormatText,
+			false,
+			false,
+			"Part 1: 21\nPart 2: 40 merged_spans=3 raw_total=45\nPart 1: 40\nPart 2: 1180591620717411303424\n",
+			false,
+		},
+		{
+			"Text with day headers",
+			FormatText,
+			true,
+			false,
+			"Day 7\nPart 1: 21\nPart 2: 40 merged_spans=3 raw_total=45\nDay 8\nPart 1: 40\nPart 2: 1180591620717411303424\n",
+			false,
+		},
+		{
+			"Text with phases",
+			FormatText,
+			false,
+			true,
+			"Part 1: 21 (1.5µs: parse 1µs, solve 500ns)\nPart 2: 40 merged_spans=3 raw_total=45 (2.5µs)\nPart 1: 40 (10ns)\nPart 2: 1180591620717411303424 (20ns)\n",
+			false,
+		},
+		{
+			"JSON",
+			FormatJSON,
+			true,
+			false,
+			`{"day":7,"part":1,"answer":21,"elapsed_ns":1500,"phases":[{"name":"parse","elapsed_ns":1000},{"name":"solve","elapsed_ns":500}]}
+{"day":7,"part":2,"answer":40,"elapsed_ns":2500,"details":{"merged_spans":3,"raw_total":45}}
+{"day":8,"part":1,"answer":40,"elapsed_ns":10}
+{"day":8,"part":2,"answer":1180591620717411303424,"elapsed_ns":20}
+`,
+			false,
+		},
+		{
+			"Invalid format",
+			"xml",
+			false,
+			false,
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			out, err := NewResultWriter(&buf, tt.format, tt.withDayHeaders, tt.withPhases)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("NewResultWriter() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if tt.errorExpected {
+				return
+			}
+			for _, r := range results {
+				if err := out.WriteResult(r); err != nil {
+					t.Fatalf("WriteResult() error = %v", err)
+				}
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("WriteResult() output = %q, want %q", buf.String(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package aoc
+
+import (
+	"context"
+	"runtime/trace"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Phase names shared by the days so that timings line up across them.
+const (
+	PhaseParse      = "parse"
+	PhasePrecompute = "precompute"
+	PhaseSolve      = "solve"
+)
+
+type PhaseTiming struct {
+	Name    string        `json:"name"`
+	Elapsed time.Duration `json:"elapsed_ns"`
+}
+
+type phaseRecorder struct {
+	mu     sync.Mutex
+	phases []PhaseTiming
+}
+
+func (r *phaseRecorder) add(name string, elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := slices.IndexFunc(r.phases, func(p PhaseTiming) bool { return p.Name == name })
+	if i == -1 {
+		r.phases = append(r.phases, PhaseTiming{Name: name, Elapsed: elapsed})
+		return
+	}
+	r.phases[i].Elapsed += elapsed
+}
+
+type phaseRecorderKey struct{}
+
+// WithPhases returns a context in which StartPhase records timings, together
+// with a function returning the timings recorded so far in order of first use.
+func WithPhases(ctx context.Context) (context.Context, func() []PhaseTiming) {
+	r := &phaseRecorder{}
+	return context.WithValue(ctx, phaseRecorderKey{}, r), func() []PhaseTiming {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return slices.Clone(r.phases)
+	}
+}
+
+// StartPhase starts timing a phase of a solver and returns the function ending
+// it. A phase entered several times accumulates its time. Phases also show up
+// as regions in execution traces.
+func StartPhase(ctx context.Context, name string) (end func()) {
+	region := trace.StartRegion(ctx, name)
+	r, _ := ctx.Value(phaseRecorderKey{}).(*phaseRecorder)
+	start := time.Now()
+	return func() {
+		region.End()
+		if r != nil {
+			r.add(name, time.Since(start))
+		}
+	}
+}
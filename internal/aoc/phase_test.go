@@ -0,0 +1,36 @@
+package aoc
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPhases(t *testing.T) {
+	ctx, phases := WithPhases(t.Context())
+	end := StartPhase(ctx, PhaseParse)
+	time.Sleep(time.Millisecond)
+	end()
+	wg := sync.WaitGroup{}
+	for range 4 {
+		wg.Go(func() {
+			defer StartPhase(ctx, PhaseSolve)()
+			time.Sleep(time.Millisecond)
+		})
+	}
+	wg.Wait()
+
+	result := phases()
+	if len(result) != 2 || result[0].Name != PhaseParse || result[1].Name != PhaseSolve {
+		t.Fatalf("phases() = %v, want %s then %s", result, PhaseParse, PhaseSolve)
+	}
+	if result[0].Elapsed < time.Millisecond || result[1].Elapsed < 4*time.Millisecond {
+		t.Errorf("phases() = %v, want at least 1ms and 4ms", result)
+	}
+
+	// Phases outside of WithPhases are not recorded anywhere.
+	StartPhase(t.Context(), PhaseSolve)()
+	if len(phases()) != 2 {
+		t.Errorf("phases() = %v, want it unchanged", phases())
+	}
+}
@@ -0,0 +1,87 @@
+package aoc
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+type profileFlags struct {
+	cpuProfile string
+	memProfile string
+	trace      string
+}
+
+func (p *profileFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&p.cpuProfile, "cpuprofile", "", "write a CPU profile to `file`")
+	fs.StringVar(&p.memProfile, "memprofile", "", "write a heap profile to `file` once done")
+	fs.StringVar(&p.trace, "trace", "", "write an execution trace to `file`")
+}
+
+// start begins the requested CPU profile and execution trace. The returned
+// function stops them and writes the heap profile.
+func (p *profileFlags) start() (stop func() error, err error) {
+	var closers []func() error
+	closeAll := func() error {
+		var errs []error
+		for _, c := range closers {
+			errs = append(errs, c())
+		}
+		return errors.Join(errs...)
+	}
+
+	if p.cpuProfile != "" {
+		f, err := os.Create(p.cpuProfile)
+		if err != nil {
+			return nil, fmt.Errorf("error creating CPU profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error starting CPU profile: %w", err)
+		}
+		closers = append(closers, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+	if p.trace != "" {
+		f, err := os.Create(p.trace)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("error creating trace: %w", err)
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			closeAll()
+			return nil, fmt.Errorf("error starting trace: %w", err)
+		}
+		closers = append(closers, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+	return func() error {
+		err := closeAll()
+		if p.memProfile != "" {
+			err = errors.Join(err, writeHeapProfile(p.memProfile))
+		}
+		return err
+	}, nil
+}
+
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating heap profile: %w", err)
+	}
+	defer f.Close()
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		return fmt.Errorf("error writing heap profile: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package aoc
+
+import (
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"slices"
+)
+
+type Solver interface {
+	Part1(ctx context.Context, input io.Reader) (int, error)
+	Part2(ctx context.Context, input io.Reader) (int, error)
+}
+
+// FlagRegisterer is implemented by solvers exposing day specific options,
+// e.g. day 2's --method.
+type FlagRegisterer interface {
+	RegisterFlags(fs *flag.FlagSet)
+}
+
+// WorkerSetter is implemented by solvers backed by a worker pool, a count of
+// zero or less selecting runtime.GOMAXPROCS workers.
+type WorkerSetter interface {
+	SetWorkers(n int)
+}
+
+// Reporter is implemented by solvers that can write details besides their
+// answers, e.g. day 1's --solve-start. Only aoc run hands them a writer,
+// stderr, keeping reports out of its results.
+type Reporter interface {
+	SetReport(w io.Writer)
+}
+
+// BigSolver is implemented by solvers whose answers may not fit in an int,
+// e.g. day 2's --big. aoc run solves parts with BigPart when UseBig reports
+// true, or when their int answers fail with bigsum.ErrOverflow.
+type BigSolver interface {
+	UseBig() bool
+	BigPart(ctx context.Context, part int, input io.Reader) (*big.Int, error)
+}
+
+// Detailer is implemented by solvers working out figures besides the answer
+// of the part they last solved, e.g. day 2's --dedup raw total. aoc run adds
+// them to its results.
+type Detailer interface {
+	Details() map[string]int
+}
+
+type Day struct {
+	Number    int
+	NewSolver func() Solver
+}
+
+func SolvePart(ctx context.Context, s Solver, part int, input io.Reader) (int, error) {
+	switch part {
+	case 1:
+		return s.Part1(ctx, input)
+	case 2:
+		return s.Part2(ctx, input)
+	default:
+		return 0, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+var registry = make(map[int]Day)
+
+func Register(d Day) {
+	if d.NewSolver == nil {
+		panic(fmt.Sprintf("day %d registered without a solver", d.Number))
+	}
+	if _, exists := registry[d.Number]; exists {
+		panic(fmt.Sprintf("day %d registered twice", d.Number))
+	}
+	registry[d.Number] = d
+}
+
+func Lookup(number int) (Day, error) {
+	d, ok := registry[number]
+	if !ok {
+		return Day{}, fmt.Errorf("day %d is not registered", number)
+	}
+	return d, nil
+}
+
+func Days() []Day {
+	return slices.SortedFunc(maps.Values(registry), func(a, b Day) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+}
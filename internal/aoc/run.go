@@ -0,0 +1,132 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+)
+
+func Main(number int, args []string) int {
+	day, err := Lookup(number)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := Run(ctx, os.Stdout, []Day{day}, filepath.Base(os.Args[0]), args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+func Run(ctx context.Context, w io.Writer, days []Day, name string, args []string) error {
+	f := newDayFlags(name, days)
+	format := f.fs.String("format", FormatText, "output format: "+FormatText+"|"+FormatJSON)
+	withPhases := f.fs.Bool("phases", false, "show phase timings in text output")
+	var profiles profileFlags
+	profiles.register(f.fs)
+	if err := f.parse(args); err != nil {
+		return err
+	}
+
+	out, err := NewResultWriter(w, *format, len(days) > 1, *withPhases)
+	if err != nil {
+		return err
+	}
+	// Reports go to stderr so they never interleave with the result stream,
+	// which must stay parseable with --format json.
+	for _, s := range f.solvers {
+		if r, ok := s.(Reporter); ok {
+			r.SetReport(os.Stderr)
+		}
+	}
+
+	stopProfiles, err := profiles.start()
+	if err != nil {
+		return err
+	}
+	for i, d := range days {
+		if err := runDay(ctx, out, d.Number, f.solvers[i], f.parts(), f.inputSpec); err != nil {
+			return errors.Join(err, stopProfiles())
+		}
+	}
+	return stopProfiles()
+}
+
+func runDay(ctx context.Context, out ResultWriter, number int, s Solver, parts []int, inputSpec string) error {
+	// A single part reads its input once, so stdin need not be spooled.
+	var input io.ReadCloser
+	var err error
+	if len(parts) == 1 {
+		input, err = OpenStream(inputSpec, number)
+	} else {
+		input, err = OpenInput(inputSpec, number)
+	}
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	for _, part := range parts {
+		if err := rewind(input); err != nil {
+			return err
+		}
+		partCtx, phases := WithPhases(ctx)
+		start := time.Now()
+		result := Result{Day: number, Part: part}
+		result.Answer, result.BigAnswer, err = solve(partCtx, s, part, input)
+		if err != nil {
+			return fmt.Errorf("day %d part %d: %w", number, part, err)
+		}
+		result.Elapsed, result.Phases = time.Since(start), phases()
+		if d, ok := s.(Detailer); ok {
+			result.Details = d.Details()
+		}
+		if err := out.WriteResult(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// solve answers part with BigPart when s asks for it. Otherwise an answer
+// overflowing an int is worked out again with BigPart, provided that input
+// can be rewound, the error being kept when that fails too.
+func solve(ctx context.Context, s Solver, part int, input io.Reader) (int, *big.Int, error) {
+	bs, isBig := s.(BigSolver)
+	if isBig && bs.UseBig() {
+		answer, err := bs.BigPart(ctx, part, input)
+		return 0, answer, err
+	}
+	answer, err := SolvePart(ctx, s, part, input)
+	if _, rewindable := input.(io.Seeker); !isBig || !rewindable || !errors.Is(err, bigsum.ErrOverflow) {
+		return answer, nil, err
+	}
+	if rewind(input) != nil {
+		return 0, nil, err
+	}
+	bigAnswer, bigErr := bs.BigPart(ctx, part, input)
+	if bigErr != nil {
+		return 0, nil, err
+	}
+	return 0, bigAnswer, nil
+}
+
+// rewind seeks input back to its start, unless it is a stream read once.
+func rewind(input io.Reader) error {
+	if seeker, ok := input.(io.Seeker); ok {
+		_, err := seeker.Seek(0, io.SeekStart)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+)
+
+// sumSolver sums the numbers of its input, part 1 failing with
+// bigsum.ErrOverflow when the total does not fit in an int.
+type sumSolver struct {
+	big bool
+}
+
+func (s sumSolver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	total, err := s.BigPart(ctx, 1, input)
+	if err != nil {
+		return 0, err
+	}
+	return bigsum.Int(total)
+}
+
+func (s sumSolver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return s.Part1(ctx, input)
+}
+
+func (s sumSolver) UseBig() bool {
+	return s.big
+}
+
+func (sumSolver) BigPart(_ context.Context, _ int, input io.Reader) (*big.Int, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	for _, field := range strings.Fields(string(data)) {
+		n, ok := new(big.Int).SetString(field, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+		total.Add(total, n)
+	}
+	return total, nil
+}
+
+func TestSolve(t *testing.T) {
+	const overflowing = "9223372036854775807 1"
+	tests := []struct {
+		name          string
+		solver        Solver
+		input         io.Reader
+		expected      string
+		errorExpected bool
+	}{
+		{"Int", sumSolver{}, strings.NewReader("1 2"), "3", false},
+		{"Big", sumSolver{big: true}, strings.NewReader("1 2"), "3", false},
+		{"Overflow retried", sumSolver{}, strings.NewReader(overflowing), "9223372036854775808", false},
+		{"Overflow of a stream", sumSolver{}, io.MultiReader(strings.NewReader(overflowing)), "", true},
+		{"Without BigPart", lineCountSolver{}, strings.NewReader("a\n"), "1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, bigAnswer, err := solve(t.Context(), tt.solver, 1, tt.input)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("solve() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if tt.errorExpected {
+				if !errors.Is(err, bigsum.ErrOverflow) {
+					t.Errorf("solve() error = %v, want overflow", err)
+				}
+				return
+			}
+			result := fmt.Sprint(answer)
+			if bigAnswer != nil {
+				result = bigAnswer.String()
+			}
+			if result != tt.expected {
+				t.Errorf("solve() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
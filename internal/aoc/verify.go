@@ -0,0 +1,179 @@
+package aoc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const answersFileName = "answers.txt"
+
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusMismatch Status = "mismatch"
+	StatusMissing  Status = "missing"
+	StatusError    Status = "error"
+)
+
+type Verification struct {
+	Day      int
+	Part     int
+	Status   Status
+	Answer   int
+	Expected int
+	Elapsed  time.Duration
+	Err      error
+}
+
+// ParseAnswers reads the expected answers of a day, one line per part in order.
+// A blank line marks an answer that is not known yet.
+func ParseAnswers(input io.Reader) (map[int]int, error) {
+	lineScanner := bufio.NewScanner(input)
+	answers := make(map[int]int)
+	part := 0
+	for lineScanner.Scan() {
+		part++
+		line := strings.TrimSpace(lineScanner.Text())
+		if line == "" {
+			continue
+		}
+		answer, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid answer for part %d: %q", part, line)
+		}
+		answers[part] = answer
+	}
+	if err := lineScanner.Err(); err != nil {
+		return nil, err
+	}
+	return answers, nil
+}
+
+// AnswersPath returns the answers.txt kept next to the input of a day.
+func AnswersPath(inputSpec string, day int) (string, error) {
+	if inputSpec == StdinInput {
+		return "", errors.New("answers cannot be located for stdin input")
+	}
+	inputPath, err := InputPath(inputSpec, day)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(inputPath), answersFileName), nil
+}
+
+// LoadAnswers reads the answers.txt of a day, a missing file meaning that no
+// answer is known yet.
+func LoadAnswers(inputSpec string, day int) (map[int]int, error) {
+	answersPath, err := AnswersPath(inputSpec, day)
+	if err != nil {
+		return nil, err
+	}
+	answersFile, err := os.Open(answersPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[int]int{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer answersFile.Close()
+	answers, err := ParseAnswers(answersFile)
+	if err != nil {
+		return nil, fmt.Errorf("day %d: %s: %w", day, answersPath, err)
+	}
+	return answers, nil
+}
+
+// Verify solves the requested parts of a day and compares them against its
+// answers.txt. Parts without a known answer are reported as missing without
+// being solved, since some of them do not finish in reasonable time.
+func Verify(ctx context.Context, number int, s Solver, parts []int, inputSpec string) ([]Verification, error) {
+	answers, err := LoadAnswers(inputSpec, number)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := OpenInput(inputSpec, number)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	verifications := make([]Verification, 0, len(parts))
+	for _, part := range parts {
+		v := Verification{Day: number, Part: part}
+		expected, ok := answers[part]
+		if !ok {
+			v.Status = StatusMissing
+			verifications = append(verifications, v)
+			continue
+		}
+		v.Expected = expected
+		if _, err := input.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		v.Answer, v.Err = SolvePart(ctx, s, part, input)
+		v.Elapsed = time.Since(start)
+		switch {
+		case v.Err != nil:
+			v.Status = StatusError
+		case v.Answer != v.Expected:
+			v.Status = StatusMismatch
+		default:
+			v.Status = StatusOK
+		}
+		verifications = append(verifications, v)
+	}
+	return verifications, nil
+}
+
+// RunVerify verifies days and prints a report, failing if any part produced
+// an error or a wrong answer.
+func RunVerify(ctx context.Context, w io.Writer, days []Day, name string, args []string) error {
+	f := newDayFlags(name, days)
+	if err := f.parse(args); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tSTATUS\tANSWER\tEXPECTED\tELAPSED")
+	failures := 0
+	for i, d := range days {
+		verifications, err := Verify(ctx, d.Number, f.solvers[i], f.parts(), f.inputSpec)
+		if err != nil {
+			return err
+		}
+		for _, v := range verifications {
+			answer, expected, elapsed := "-", "-", "-"
+			if v.Status != StatusMissing {
+				answer = strconv.Itoa(v.Answer)
+				expected = strconv.Itoa(v.Expected)
+				elapsed = v.Elapsed.String()
+			}
+			if v.Err != nil {
+				answer = v.Err.Error()
+			}
+			if v.Status == StatusError || v.Status == StatusMismatch {
+				failures++
+			}
+			fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\n", v.Day, v.Part, v.Status, answer, expected, elapsed)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d parts failed verification", failures)
+	}
+	return nil
+}
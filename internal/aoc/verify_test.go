@@ -0,0 +1,96 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type lineCountSolver struct{}
+
+func (lineCountSolver) Part1(_ context.Context, input io.Reader) (int, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return 0, err
+	}
+	return strings.Count(string(data), "\n"), nil
+}
+
+func (lineCountSolver) Part2(_ context.Context, _ io.Reader) (int, error) {
+	return 0, errors.New("unsolvable")
+}
+
+func TestParseAnswers(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      map[int]int
+		errorExpected bool
+	}{
+		{"Both parts", "1180\n6892\n", map[int]int{1: 1180, 2: 6892}, false},
+		{"Only part 1", "475\n", map[int]int{1: 475}, false},
+		{"Only part 2", "\n 42 \n", map[int]int{2: 42}, false},
+		{"Invalid answer", "abc\n", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseAnswers(strings.NewReader(tt.input))
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("ParseAnswers() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("ParseAnswers() = %v, want %v", result, tt.expected)
+			}
+			for part, answer := range tt.expected {
+				if result[part] != answer {
+					t.Errorf("ParseAnswers()[%d] = %v, want %v", part, result[part], answer)
+				}
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name     string
+		answers  string
+		expected []Status
+	}{
+		{"Correct answer", "3\n7\n", []Status{StatusOK, StatusError}},
+		{"Wrong answer", "4\n", []Status{StatusMismatch, StatusMissing}},
+		{"No answers file", "", []Status{StatusMissing, StatusMissing}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			dayDir := filepath.Join(dir, "3")
+			if err := os.Mkdir(dayDir, 0o755); err != nil {
+				t.Fatalf("Mkdir() error = %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(dayDir, "input.txt"), []byte("a\nb\nc\n"), 0o644); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+			if tt.answers != "" {
+				if err := os.WriteFile(filepath.Join(dayDir, "answers.txt"), []byte(tt.answers), 0o644); err != nil {
+					t.Fatalf("WriteFile() error = %v", err)
+				}
+			}
+			verifications, err := Verify(t.Context(), 3, lineCountSolver{}, []int{1, 2}, dir)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if len(verifications) != len(tt.expected) {
+				t.Fatalf("Verify() = %v, want statuses %v", verifications, tt.expected)
+			}
+			for i, v := range verifications {
+				if v.Status != tt.expected[i] {
+					t.Errorf("Verify()[%d].Status = %v, want %v", i, v.Status, tt.expected[i])
+				}
+			}
+		})
+	}
+}
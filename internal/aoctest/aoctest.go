@@ -0,0 +1,47 @@
+// Package aoctest verifies registered days against their real inputs from
+// within the day packages' tests.
+package aoctest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+)
+
+// VerifyAnswers solves both parts of a day on its real input, found through
+// $AOC_INPUT_DIR or the repository's cmd directory relative to a day package,
+// and checks them against answers.txt.
+func VerifyAnswers(t *testing.T, number int) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping real input verification in short mode")
+	}
+	inputDir := os.Getenv(aoc.InputDirEnv)
+	if inputDir == "" {
+		inputDir = filepath.Join("..", "..", "cmd")
+	}
+	d, err := aoc.Lookup(number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifications, err := aoc.Verify(t.Context(), number, d.NewSolver(), []int{1, 2}, inputDir)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	for _, v := range verifications {
+		t.Run(fmt.Sprintf("Part %d", v.Part), func(t *testing.T) {
+			switch v.Status {
+			case aoc.StatusMissing:
+				t.Skip("no known answer")
+			case aoc.StatusError:
+				t.Fatalf("error = %v", v.Err)
+			case aoc.StatusMismatch:
+				t.Errorf("got = %v, want %v", v.Answer, v.Expected)
+			}
+			t.Logf("solved in %v", v.Elapsed)
+		})
+	}
+}
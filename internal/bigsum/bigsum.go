@@ -0,0 +1,32 @@
+// Package bigsum provides the arbitrary precision sums shared by the days
+// whose answers may not fit in an int.
+package bigsum
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrOverflow = errors.New("overflows int")
+
+// Add sums a and b into a new value, nil standing for zero, so it can be used
+// as a workpool reducer.
+func Add(a, b *big.Int) *big.Int {
+	sum := new(big.Int)
+	if a != nil {
+		sum.Add(sum, a)
+	}
+	if b != nil {
+		sum.Add(sum, b)
+	}
+	return sum
+}
+
+// Int returns x as an int, wrapping ErrOverflow when it does not fit.
+func Int(x *big.Int) (int, error) {
+	if !x.IsInt64() || int64(int(x.Int64())) != x.Int64() {
+		return 0, fmt.Errorf("%v %w", x, ErrOverflow)
+	}
+	return int(x.Int64()), nil
+}
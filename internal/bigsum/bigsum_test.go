@@ -0,0 +1,58 @@
+package bigsum
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        *big.Int
+		b        *big.Int
+		expected *big.Int
+	}{
+		{"Both nil", nil, nil, big.NewInt(0)},
+		{"Nil left", nil, big.NewInt(7), big.NewInt(7)},
+		{"Nil right", big.NewInt(7), nil, big.NewInt(7)},
+		{"Beyond int", big.NewInt(math.MaxInt64), big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 63)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Add(tt.a, tt.b)
+			if result.Cmp(tt.expected) != 0 {
+				t.Errorf("Add() = %v, want %v", result, tt.expected)
+			}
+			if result == tt.a || result == tt.b {
+				t.Errorf("Add() reused an operand")
+			}
+		})
+	}
+}
+
+func TestInt(t *testing.T) {
+	tests := []struct {
+		name          string
+		x             *big.Int
+		expected      int
+		errorExpected bool
+	}{
+		{"Zero", big.NewInt(0), 0, false},
+		{"Max", big.NewInt(math.MaxInt), math.MaxInt, false},
+		{"Min", big.NewInt(math.MinInt), math.MinInt, false},
+		{"Beyond max", new(big.Int).Lsh(big.NewInt(1), 63), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Int(tt.x)
+			if (err != nil) != tt.errorExpected || (err != nil && !errors.Is(err, ErrOverflow)) {
+				t.Fatalf("Int() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if result != tt.expected {
+				t.Errorf("Int() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package day01
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+)
+
+const (
+	startingPosition = 50
+	dialLength       = 100
+)
+
+type Direction byte
+
+const (
+	DirectionLeft  Direction = 'L'
+	DirectionRight Direction = 'R'
+)
+
+type Rotation struct {
+	Direction      Direction
+	Steps          int
+	ExtraRotations int
+}
+
+// Apply returns where a dial of the given length pointing at startPosition
+// ends after r, its extra rotations leaving the dial where its steps do. The
+// zeros met on the way are counted by CountZeroPasses.
+func (r Rotation) Apply(startPosition, dialLength int) (int, error) {
+	if dialLength <= 0 {
+		return 0, fmt.Errorf("invalid dial length %d", dialLength)
+	}
+	steps := r.Steps % dialLength
+	switch r.Direction {
+	case DirectionLeft:
+		return (startPosition - steps + dialLength) % dialLength, nil
+	case DirectionRight:
+		return (startPosition + steps) % dialLength, nil
+	default:
+		return 0, fmt.Errorf("invalid direction %q in rotation", r.Direction)
+	}
+}
+
+// ParseRotation parses a direction followed by a decimal number of steps,
+// rejecting signs, whitespace and step counts that do not fit in an int.
+// Errors report the 1-based column at fault.
+func ParseRotation(s string, dialLength int) (Rotation, error) {
+	if dialLength <= 0 {
+		return Rotation{}, fmt.Errorf("invalid dial length %d", dialLength)
+	}
+	if s == "" {
+		return Rotation{}, errors.New("column 1: empty rotation string")
+	}
+
+	dir := Direction(s[0])
+	if dir != DirectionLeft && dir != DirectionRight {
+		return Rotation{}, fmt.Errorf("column 1: invalid direction %q in rotation string: %s", s[0], s)
+	}
+	if len(s) == 1 {
+		return Rotation{}, fmt.Errorf("column 2: missing steps in rotation string: %s", s)
+	}
+
+	steps := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return Rotation{}, fmt.Errorf("column %d: unexpected %q in steps of rotation string: %s", i+1, s[i], s)
+		}
+		digit := int(s[i] - '0')
+		if steps > (math.MaxInt-digit)/10 {
+			return Rotation{}, fmt.Errorf("column %d: steps overflow in rotation string: %s", i+1, s)
+		}
+		steps = 10*steps + digit
+	}
+
+	normalizedSteps := steps % dialLength
+	extraRotations := steps / dialLength
+
+	return Rotation{
+		Direction:      dir,
+		Steps:          normalizedSteps,
+		ExtraRotations: extraRotations,
+	}, nil
+}
+
+func Part1(ctx context.Context, input io.Reader, startingPosition, dialLength int) (int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	tallies, err := simulate(input, startingPosition, dialLength)
+	if err != nil {
+		return 0, err
+	}
+	return tallies[0].EndedAtZero, nil
+}
+
+func Part2(ctx context.Context, input io.Reader, startingPosition, dialLength int) (int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	tallies, err := simulate(input, startingPosition, dialLength)
+	if err != nil {
+		return 0, err
+	}
+	return tallies[0].ZeroCrossings, nil
+}
+
+func simulate(input io.Reader, startingPosition, dialLength int) ([]Tally, error) {
+	dial, err := NewDial(dialLength, startingPosition)
+	if err != nil {
+		return nil, err
+	}
+	return Simulate(input, []Dial{dial}, nil)
+}
+
+// Solver simulates several dials over the same rotations, its answers being
+// the totals over all of them and their own tallies being kept among the
+// details. The trace of every rotation is written once,
+// by the first part run.
+//
+// With Summarize set, the rotations are composed as RotationSummary segments
+// evaluated in parallel instead of being walked one by one, so no trace can
+// be written.
+//
+// With SolveStart set to a count, the answers are instead the numbers of start
+// positions of the dials giving that count, the positions being reported.
+type Solver struct {
+	Dials       []Dial
+	TracePath   string
+	TraceFormat string
+	SolveStart  int
+	Summarize   bool
+	Workers     int
+
+	report  io.Writer
+	details map[string]int
+	traced  bool
+}
+
+func NewSolver() *Solver {
+	return &Solver{
+		Dials:       []Dial{{Length: dialLength, Position: startingPosition}},
+		TraceFormat: TraceCSV,
+		SolveStart:  -1,
+		report:      io.Discard,
+	}
+}
+
+func (s *Solver) SetWorkers(n int) {
+	s.Workers = n
+}
+
+func (s *Solver) SetReport(w io.Writer) {
+	s.report = w
+}
+
+// Details returns the tallies of every dial, or the numbers of their start
+// positions, when there are several dials, whose answers are then totals.
+func (s *Solver) Details() map[string]int {
+	return s.details
+}
+
+func (s *Solver) RegisterFlags(fs *flag.FlagSet) {
+	fs.Func("dials", "comma separated length:start dials to simulate (default "+dialsString(s.Dials)+")", func(v string) error {
+		dials, err := ParseDials(v)
+		if err != nil {
+			return err
+		}
+		s.Dials = dials
+		return nil
+	})
+	fs.StringVar(&s.TracePath, "dial-trace", s.TracePath, "write every rotation of every dial to `file`")
+	fs.StringVar(&s.TraceFormat, "dial-trace-format", s.TraceFormat, "dial trace format: "+TraceCSV+"|"+TraceJSON)
+	fs.BoolVar(&s.Summarize, "summary", s.Summarize, "compose rotation summaries in parallel instead of walking the rotations")
+	fs.Func("solve-start", "find the start positions giving this count instead of solving, -1 to solve (default "+strconv.Itoa(s.SolveStart)+")", func(v string) error {
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		if count < -1 {
+			return fmt.Errorf("invalid count %d, expected -1 to solve or a count of zeros", count)
+		}
+		s.SolveStart = count
+		return nil
+	})
+}
+
+func dialsString(dials []Dial) string {
+	specs := make([]string, len(dials))
+	for i, d := range dials {
+		specs[i] = d.String()
+	}
+	return strings.Join(specs, ",")
+}
+
+func (s *Solver) simulate(ctx context.Context, input io.Reader) (tallies []Tally, err error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	if s.Summarize {
+		if s.TracePath != "" {
+			return nil, errors.New("--dial-trace is not supported with --summary")
+		}
+		return s.summarize(ctx, input)
+	}
+	if s.TracePath == "" || s.traced {
+		return Simulate(input, s.Dials, nil)
+	}
+
+	f, err := os.Create(s.TracePath)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dial trace: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+	tw, err := NewTraceWriter(f, s.TraceFormat)
+	if err != nil {
+		return nil, err
+	}
+	tallies, err = Simulate(input, s.Dials, tw.WriteStep)
+	if err != nil {
+		return nil, err
+	}
+	s.traced = true
+	return tallies, tw.Flush()
+}
+
+func (s *Solver) summarize(ctx context.Context, input io.Reader) ([]Tally, error) {
+	lines, err := readLines(input)
+	if err != nil {
+		return nil, err
+	}
+	tallies := make([]Tally, len(s.Dials))
+	for i, d := range s.Dials {
+		rotations, err := ParseRotations(lines, d.Length)
+		if err != nil {
+			return nil, err
+		}
+		summary, err := SummarizeAll(ctx, rotations, d.Length, s.Workers)
+		if err != nil {
+			return nil, err
+		}
+		_, tallies[i] = summary.Tally(d.Position)
+	}
+	return tallies, nil
+}
+
+func (s *Solver) solveStart(ctx context.Context, input io.Reader, part int) (int, error) {
+	if s.Summarize || s.TracePath != "" {
+		return 0, errors.New("--summary and --dial-trace are not supported with --solve-start")
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	lines, err := readLines(input)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for i, d := range s.Dials {
+		rotations, err := ParseRotations(lines, d.Length)
+		if err != nil {
+			return 0, err
+		}
+		positions, err := FindStartPositions(rotations, d.Length, s.SolveStart, part)
+		if err != nil {
+			return 0, err
+		}
+		fmt.Fprintf(s.report, "part %d, dial %d of length %d: %d start positions give %d zeros: %s\n",
+			part, i, d.Length, len(positions), s.SolveStart, joinInts(positions))
+		if len(s.Dials) > 1 {
+			s.details[fmt.Sprintf("dial%d_start_positions", i)] = len(positions)
+		}
+		total += len(positions)
+	}
+	return total, nil
+}
+
+// tally answers part from the tallies of the dials, keeping each of them among
+// the details when there are several.
+func (s *Solver) tally(tallies []Tally, part int) int {
+	total := 0
+	for i, t := range tallies {
+		if len(tallies) > 1 {
+			s.details[fmt.Sprintf("dial%d_ended_at_zero", i)] = t.EndedAtZero
+			s.details[fmt.Sprintf("dial%d_zero_crossings", i)] = t.ZeroCrossings
+		}
+		if part == 1 {
+			total += t.EndedAtZero
+		} else {
+			total += t.ZeroCrossings
+		}
+	}
+	return total
+}
+
+func joinInts(values []int) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, " ")
+}
+
+func (s *Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return s.solve(ctx, input, 1)
+}
+
+func (s *Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return s.solve(ctx, input, 2)
+}
+
+func (s *Solver) solve(ctx context.Context, input io.Reader, part int) (int, error) {
+	s.details = make(map[string]int)
+	if s.SolveStart >= 0 {
+		return s.solveStart(ctx, input, part)
+	}
+	tallies, err := s.simulate(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	return s.tally(tallies, part), nil
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 1, NewSolver: func() aoc.Solver { return NewSolver() }})
+}
@@ -0,0 +1,759 @@
+package day01
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/aoctest"
+)
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              string
+		startingPosition   int
+		dialLength         int
+		expectedZeroCounts int
+	}{
+		{
+			"Provided example",
+			`L68
+L30
+R48
+L5
+R60
+L55
+L1
+L99
+R14
+L82`,
+			startingPosition,
+			dialLength,
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zeroCounts, err := Part1(
+				t.Context(),
+				strings.NewReader(tt.input),
+				tt.startingPosition,
+				tt.dialLength,
+			)
+			if err != nil {
+				t.Fatalf("Part 1 error = %v", err)
+			}
+			if zeroCounts != tt.expectedZeroCounts {
+				t.Errorf("Part 1 got = %v, want %v", zeroCounts, tt.expectedZeroCounts)
+			}
+		})
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              string
+		startingPosition   int
+		dialLength         int
+		expectedZeroCounts int
+	}{
+		{
+			"Provided example",
+			`L68
+L30
+R48
+L5
+R60
+L55
+L1
+L99
+R14
+L82`,
+			startingPosition,
+			dialLength,
+			6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zeroCounts, err := Part2(
+				t.Context(),
+				strings.NewReader(tt.input),
+				tt.startingPosition,
+				tt.dialLength,
+			)
+			if err != nil {
+				t.Fatalf("Part 2 error = %v", err)
+			}
+			if zeroCounts != tt.expectedZeroCounts {
+				t.Errorf("Part 2 got = %v, want %v", zeroCounts, tt.expectedZeroCounts)
+			}
+		})
+	}
+}
+
+func TestParseDials(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      []Dial
+		errorExpected bool
+	}{
+		{"Single dial", "100:50", []Dial{{100, 50}}, false},
+		{"Several dials", "100:50, 10:0,7:6", []Dial{{100, 50}, {10, 0}, {7, 6}}, false},
+		{"Missing start", "100", nil, true},
+		{"Invalid length", "x:0", nil, true},
+		{"Zero length", "0:0", nil, true},
+		{"Start outside dial", "10:10", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDials(tt.input)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("ParseDials() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("ParseDials() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	dials := []Dial{{100, 50}, {10, 0}}
+	var steps []Step
+	tallies, err := Simulate(strings.NewReader("L68\nR1000\n"), dials, func(s Step) error {
+		steps = append(steps, s)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	expectedSteps := []Step{
+		{Dial: 0, Line: 1, Rotation: "L68", Start: 50, End: 82, ZeroCrossings: 1},
+		{Dial: 1, Line: 1, Rotation: "L68", Start: 0, End: 2, ZeroCrossings: 6},
+		{Dial: 0, Line: 2, Rotation: "R1000", Start: 82, End: 82, ZeroCrossings: 10},
+		{Dial: 1, Line: 2, Rotation: "R1000", Start: 2, End: 2, ZeroCrossings: 100},
+	}
+	if !slices.Equal(steps, expectedSteps) {
+		t.Errorf("Simulate() steps = %+v, want %+v", steps, expectedSteps)
+	}
+	if expected := []Tally{{0, 11}, {0, 106}}; !slices.Equal(tallies, expected) {
+		t.Errorf("Simulate() = %v, want %v", tallies, expected)
+	}
+	if dials[0].Position != 50 {
+		t.Errorf("Simulate() modified the dials passed in: %v", dials)
+	}
+}
+
+func TestParseRotation(t *testing.T) {
+	tests := []struct {
+		input         string
+		expected      Rotation
+		expectedError string
+	}{
+		{"L68", Rotation{DirectionLeft, 68, 0}, ""},
+		{"R1000", Rotation{DirectionRight, 0, 10}, ""},
+		{"R007", Rotation{DirectionRight, 7, 0}, ""},
+		{"", Rotation{}, "column 1: empty"},
+		{"X5", Rotation{}, "column 1: invalid direction"},
+		{"L", Rotation{}, "column 2: missing steps"},
+		{"L-5", Rotation{}, "column 2: unexpected '-'"},
+		{"R+3", Rotation{}, "column 2: unexpected '+'"},
+		{" R3", Rotation{}, "column 1: invalid direction"},
+		{"R 3", Rotation{}, "column 2: unexpected ' '"},
+		{"R3 ", Rotation{}, "column 3: unexpected ' '"},
+		{"R12x4", Rotation{}, "column 4: unexpected 'x'"},
+		{"R99999999999999999999", Rotation{}, "column 20: steps overflow"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseRotation(tt.input, 100)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("ParseRotation() error = %v, want %q", err, tt.expectedError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRotation() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseRotation() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// addInputSeeds seeds f with every rotation of the puzzle input.
+func addInputSeeds(f *testing.F, add func(line string)) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		f.Fatalf("failed to read input file: %v", err)
+	}
+	for line := range strings.Lines(string(data)) {
+		add(strings.TrimSpace(line))
+	}
+}
+
+func FuzzParseRotation(f *testing.F) {
+	addInputSeeds(f, func(line string) { f.Add(line, 100) })
+	for _, seed := range []string{"", "L", "L-5", "R+3", " R3", "R3 ", "R99999999999999999999"} {
+		f.Add(seed, 100)
+	}
+	f.Fuzz(func(t *testing.T, s string, length int) {
+		if length <= 0 {
+			t.Skip()
+		}
+		rotation, err := ParseRotation(s, length)
+		if err != nil {
+			if !strings.HasPrefix(err.Error(), "column ") {
+				t.Fatalf("ParseRotation(%q) error %q does not report a column", s, err)
+			}
+			return
+		}
+		steps, err := strconv.Atoi(s[1:])
+		if err != nil || steps < 0 || s[1] == '+' {
+			t.Fatalf("ParseRotation(%q) accepted steps that are not a plain decimal", s)
+		}
+		if rotation.Steps < 0 || rotation.Steps >= length || rotation.ExtraRotations != steps/length || rotation.Steps != steps%length {
+			t.Errorf("ParseRotation(%q, %d) = %+v", s, length, rotation)
+		}
+	})
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		rotation      Rotation
+		start         int
+		expected      int
+		errorExpected bool
+	}{
+		{"Left through zero", Rotation{DirectionLeft, 68, 0}, 50, 82, false},
+		{"Right to zero", Rotation{DirectionRight, 50, 0}, 50, 0, false},
+		{"Extra rotations", Rotation{DirectionRight, 5, 3}, 99, 4, false},
+		{"Unnormalized steps", Rotation{DirectionLeft, 250, 0}, 10, 60, false},
+		{"Invalid direction", Rotation{'U', 5, 0}, 50, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.rotation.Apply(tt.start, 100)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("Apply() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if result != tt.expected {
+				t.Errorf("Apply() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+	if _, err := (&Dial{Length: 100, Position: 50}).Rotate(Rotation{'U', 5, 0}); err == nil {
+		t.Errorf("Rotate() accepted an invalid direction")
+	}
+}
+
+func FuzzApply(f *testing.F) {
+	addInputSeeds(f, func(line string) { f.Add(line, 100, 50) })
+	f.Add("L5", 1, 0)
+	f.Add("R100", 100, 0)
+	f.Fuzz(func(t *testing.T, s string, length, start int) {
+		if length <= 0 || length > 1_000 || start < 0 || start >= length {
+			t.Skip()
+		}
+		rotation, err := ParseRotation(s, length)
+		if err != nil || rotation.ExtraRotations > 1_000 {
+			t.Skip()
+		}
+		dial := Dial{Length: length, Position: start}
+		zeroPasses, err := dial.Rotate(rotation)
+		if err != nil {
+			t.Fatalf("Rotate(%q) error = %v", s, err)
+		}
+
+		clicks := rotation.ExtraRotations*length + rotation.Steps
+		if rotation.Direction == DirectionLeft {
+			clicks = -clicks
+		}
+		if expected := ((start+clicks)%length + length) % length; dial.Position != expected {
+			t.Errorf("Rotate(%q) from %d on %d ended at %d, want %d", s, start, length, dial.Position, expected)
+		}
+		if expected := CountZeroPassesBruteForce(start, rotation, length); zeroPasses != expected {
+			t.Errorf("Rotate(%q) from %d on %d passed zero %d times, want %d", s, start, length, zeroPasses, expected)
+		}
+	})
+}
+
+func TestCountZeroPasses(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    int
+		rotation string
+		length   int
+		expected int
+	}{
+		{"Start at zero turning left", 0, "L5", 100, 0},
+		{"Start at zero turning right", 0, "R5", 100, 0},
+		{"Land exactly on zero left", 5, "L5", 100, 1},
+		{"Land exactly on zero right", 95, "R5", 100, 1},
+		{"Full turn from zero", 0, "R100", 100, 1},
+		{"Full turn from zero left", 0, "L100", 100, 1},
+		{"Full turn", 50, "L100", 100, 1},
+		{"Several turns landing on zero", 50, "R250", 100, 3},
+		{"Cross without landing", 50, "L68", 100, 1},
+		{"Dial of length one", 0, "R3", 1, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotation, err := ParseRotation(tt.rotation, tt.length)
+			if err != nil {
+				t.Fatalf("ParseRotation() error = %v", err)
+			}
+			if result := CountZeroPasses(tt.start, rotation, tt.length); result != tt.expected {
+				t.Errorf("CountZeroPasses() = %v, want %v", result, tt.expected)
+			}
+			if result := CountZeroPassesBruteForce(tt.start, rotation, tt.length); result != tt.expected {
+				t.Errorf("CountZeroPassesBruteForce() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// rotationCase is a random rotation on a random dial for testing/quick.
+type rotationCase struct {
+	Start    int
+	Length   int
+	Rotation Rotation
+}
+
+func (rotationCase) Generate(r *rand.Rand, _ int) reflect.Value {
+	length := 1 + r.Intn(200)
+	return reflect.ValueOf(rotationCase{
+		Start:    r.Intn(length),
+		Length:   length,
+		Rotation: randomRotations(r, 1, length)[0],
+	})
+}
+
+func randomRotations(r *rand.Rand, n, length int) []Rotation {
+	rotations := make([]Rotation, n)
+	for i := range rotations {
+		steps := r.Intn(5 * length)
+		direction := DirectionRight
+		if r.Intn(2) == 0 {
+			direction = DirectionLeft
+		}
+		rotations[i] = Rotation{Direction: direction, Steps: steps % length, ExtraRotations: steps / length}
+	}
+	return rotations
+}
+
+func TestCountZeroPassesProperty(t *testing.T) {
+	agree := func(c rotationCase) bool {
+		return CountZeroPasses(c.Start, c.Rotation, c.Length) == CountZeroPassesBruteForce(c.Start, c.Rotation, c.Length)
+	}
+	if err := quick.Check(agree, &quick.Config{MaxCount: 10_000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func FuzzCountZeroPasses(f *testing.F) {
+	f.Add(uint8(100), uint8(50), true, uint16(68))
+	f.Add(uint8(100), uint8(0), false, uint16(100))
+	f.Add(uint8(1), uint8(0), true, uint16(0))
+	f.Fuzz(func(t *testing.T, length, start uint8, left bool, steps uint16) {
+		if length == 0 {
+			t.Skip()
+		}
+		s := fmt.Sprintf("R%d", steps)
+		if left {
+			s = fmt.Sprintf("L%d", steps)
+		}
+		rotation, err := ParseRotation(s, int(length))
+		if err != nil {
+			t.Fatalf("ParseRotation(%q) error = %v", s, err)
+		}
+		begin := int(start) % int(length)
+		expected := CountZeroPassesBruteForce(begin, rotation, int(length))
+		if result := CountZeroPasses(begin, rotation, int(length)); result != expected {
+			t.Errorf("CountZeroPasses(%d, %s, %d) = %d, want %d", begin, s, length, result, expected)
+		}
+	})
+}
+
+func TestFindStartPositions(t *testing.T) {
+	lines := strings.Fields("L68 L30 R48 L5 R60 L55 L1 L99 R14 L82")
+	for _, length := range []int{1, 7, 100} {
+		rotations, err := ParseRotations(lines, length)
+		if err != nil {
+			t.Fatalf("ParseRotations() error = %v", err)
+		}
+		for _, part := range []int{1, 2} {
+			byCount := make(map[int][]int)
+			for start := range length {
+				tallies, err := Simulate(strings.NewReader(strings.Join(lines, "\n")), []Dial{{length, start}}, nil)
+				if err != nil {
+					t.Fatalf("Simulate() error = %v", err)
+				}
+				count := tallies[0].EndedAtZero
+				if part == 2 {
+					count = tallies[0].ZeroCrossings
+				}
+				byCount[count] = append(byCount[count], start)
+			}
+			for count, expected := range byCount {
+				result, err := FindStartPositions(rotations, length, count, part)
+				if err != nil {
+					t.Fatalf("FindStartPositions() error = %v", err)
+				}
+				if !slices.Equal(result, expected) {
+					t.Errorf("FindStartPositions(length %d, count %d, part %d) = %v, want %v", length, count, part, result, expected)
+				}
+			}
+		}
+	}
+
+	if _, err := FindStartPositions(nil, 100, 0, 3); err == nil {
+		t.Errorf("FindStartPositions() accepted part 3")
+	}
+}
+
+func TestSolverSummary(t *testing.T) {
+	s := NewSolver()
+	s.Summarize = true
+	s.SetWorkers(3)
+	s.Dials = []Dial{{100, 50}, {100, 50}}
+	input := "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n"
+	for part, expected := range []int{6, 12} {
+		result, err := aoc.SolvePart(t.Context(), s, part+1, strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("Part%d() error = %v", part+1, err)
+		}
+		if result != expected {
+			t.Errorf("Part%d() = %v, want %v", part+1, result, expected)
+		}
+	}
+}
+
+func TestSolverDetails(t *testing.T) {
+	input := "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n"
+	s := NewSolver()
+	if _, err := s.Part1(t.Context(), strings.NewReader(input)); err != nil {
+		t.Fatalf("Part1() error = %v", err)
+	}
+	if len(s.Details()) != 0 {
+		t.Errorf("Details() = %v for a single dial, want none", s.Details())
+	}
+
+	s.Dials = []Dial{{100, 50}, {10, 0}}
+	tallies, err := Simulate(strings.NewReader(input), s.Dials, nil)
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	result, err := s.Part2(t.Context(), strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Part2() error = %v", err)
+	}
+	if expected := tallies[0].ZeroCrossings + tallies[1].ZeroCrossings; result != expected {
+		t.Errorf("Part2() = %v, want %v", result, expected)
+	}
+	expected := map[string]int{
+		"dial0_ended_at_zero":  tallies[0].EndedAtZero,
+		"dial0_zero_crossings": tallies[0].ZeroCrossings,
+		"dial1_ended_at_zero":  tallies[1].EndedAtZero,
+		"dial1_zero_crossings": tallies[1].ZeroCrossings,
+	}
+	if !maps.Equal(s.Details(), expected) {
+		t.Errorf("Details() = %v, want %v", s.Details(), expected)
+	}
+}
+
+func TestSolverSolveStartFlags(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		errorExpected bool
+	}{
+		{"Solve", []string{"--solve-start", "-1"}, false},
+		{"Count", []string{"--solve-start", "3"}, false},
+		{"Below -1", []string{"--solve-start", "-2"}, true},
+		{"With summary", []string{"--solve-start", "3", "--summary"}, true},
+		{"With dial trace", []string{"--solve-start", "3", "--dial-trace", "trace.csv"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			s := NewSolver()
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			s.RegisterFlags(fs)
+			err := fs.Parse(tt.args)
+			if err == nil {
+				_, err = s.Part1(t.Context(), strings.NewReader("L68\n"))
+			}
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+		})
+	}
+}
+
+func TestSolverSummaryRejectsTrace(t *testing.T) {
+	s := NewSolver()
+	s.Summarize = true
+	s.TracePath = filepath.Join(t.TempDir(), "trace.csv")
+	if _, err := s.Part1(t.Context(), strings.NewReader("L68\n")); err == nil {
+		t.Errorf("Part1() accepted --dial-trace with --summary")
+	}
+	if _, err := os.Stat(s.TracePath); !os.IsNotExist(err) {
+		t.Errorf("Part1() created the trace file, stat error = %v", err)
+	}
+}
+
+func TestSolverSolveStart(t *testing.T) {
+	const input = "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n"
+	rotations, err := ParseRotations(strings.Fields(input), 100)
+	if err != nil {
+		t.Fatalf("ParseRotations() error = %v", err)
+	}
+	positions, err := FindStartPositions(rotations, 100, 3, 1)
+	if err != nil {
+		t.Fatalf("FindStartPositions() error = %v", err)
+	}
+	if !reflect.DeepEqual(positions, []int{50}) {
+		t.Fatalf("FindStartPositions() = %v, want [50]", positions)
+	}
+
+	s := NewSolver()
+	s.SolveStart = 3
+	var report strings.Builder
+	s.SetReport(&report)
+	result, err := s.Part1(t.Context(), strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Part1() error = %v", err)
+	}
+	if result != len(positions) {
+		t.Errorf("Part1() = %v, want %v", result, len(positions))
+	}
+	expectedReport := fmt.Sprintf("part 1, dial 0 of length 100: %d start positions give 3 zeros: %s\n", len(positions), joinInts(positions))
+	if report.String() != expectedReport {
+		t.Errorf("Part1() reported %q, want %q", report.String(), expectedReport)
+	}
+}
+
+func TestRotationSummaryAssociative(t *testing.T) {
+	associative := func(seed int64, length uint8) bool {
+		l := int(length%50) + 1
+		rotations := randomRotations(rand.New(rand.NewSource(seed)), 3, l)
+		a, b, c := Summarize(rotations[0], l), Summarize(rotations[1], l), Summarize(rotations[2], l)
+		left, right := a.Then(b).Then(c), a.Then(b.Then(c))
+		identity := IdentitySummary(l)
+		return reflect.DeepEqual(left, right) && reflect.DeepEqual(identity.Then(a), a) && reflect.DeepEqual(a.Then(identity), a)
+	}
+	if err := quick.Check(associative, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSummarizeAll(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, length := range []int{1, 7, 100} {
+		rotations := randomRotations(r, 500, length)
+		for start := range length {
+			expected, err := Simulate(strings.NewReader(formatRotations(rotations, length)), []Dial{{length, start}}, nil)
+			if err != nil {
+				t.Fatalf("Simulate() error = %v", err)
+			}
+			for _, workers := range []int{1, 3, 16} {
+				summary, err := SummarizeAll(t.Context(), rotations, length, workers)
+				if err != nil {
+					t.Fatalf("SummarizeAll() error = %v", err)
+				}
+				if _, tally := summary.Tally(start); tally != expected[0] {
+					t.Fatalf("SummarizeAll(length %d, workers %d).Tally(%d) = %v, want %v", length, workers, start, tally, expected[0])
+				}
+			}
+		}
+	}
+}
+
+func TestSummaryTree(t *testing.T) {
+	const length = 10
+	r := rand.New(rand.NewSource(2))
+	rotations := randomRotations(r, 37, length)
+	tree := NewSummaryTree(rotations, length)
+	for range 200 {
+		i := r.Intn(len(rotations) + 1)
+		j := i + r.Intn(len(rotations)-i+1)
+		start := r.Intn(length)
+
+		dial := Dial{Length: length, Position: start}
+		for _, rotation := range rotations[:i] {
+			if _, err := dial.Rotate(rotation); err != nil {
+				t.Fatalf("Rotate() error = %v", err)
+			}
+		}
+		var expected Tally
+		for _, rotation := range rotations[i:j] {
+			zeroPasses, err := dial.Rotate(rotation)
+			if err != nil {
+				t.Fatalf("Rotate() error = %v", err)
+			}
+			expected.ZeroCrossings += zeroPasses
+			if dial.Position == 0 {
+				expected.EndedAtZero++
+			}
+		}
+		result, err := tree.ZerosBetween(start, i, j)
+		if err != nil {
+			t.Fatalf("ZerosBetween() error = %v", err)
+		}
+		if result != expected {
+			t.Errorf("ZerosBetween(%d, %d, %d) = %v, want %v", start, i, j, result, expected)
+		}
+	}
+	if _, err := tree.Query(5, 3); err == nil {
+		t.Errorf("Query() accepted a reversed range")
+	}
+}
+
+func formatRotations(rotations []Rotation, length int) string {
+	var sb strings.Builder
+	for _, r := range rotations {
+		fmt.Fprintf(&sb, "%c%d\n", r.Direction, r.ExtraRotations*length+r.Steps)
+	}
+	return sb.String()
+}
+
+func TestSolverTrace(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{TraceCSV, "dial,line,rotation,start,end,zero_crossings\n0,1,L68,50,82,1\n1,1,L68,3,5,7\n"},
+		{TraceJSON, `{"dial":0,"line":1,"rotation":"L68","start":50,"end":82,"zero_crossings":1}
+{"dial":1,"line":1,"rotation":"L68","start":3,"end":5,"zero_crossings":7}
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			s := NewSolver()
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			s.RegisterFlags(fs)
+			tracePath := filepath.Join(t.TempDir(), "trace")
+			if err := fs.Parse([]string{"--dials", "100:50,10:3", "--dial-trace", tracePath, "--dial-trace-format", tt.format}); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			for part, expected := range []int{0, 8} {
+				result, err := aoc.SolvePart(t.Context(), s, part+1, strings.NewReader("L68\n"))
+				if err != nil {
+					t.Fatalf("Part%d() error = %v", part+1, err)
+				}
+				if result != expected {
+					t.Errorf("Part%d() = %v, want %v", part+1, result, expected)
+				}
+			}
+			data, err := os.ReadFile(tracePath)
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("trace = %q, want %q", data, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 1)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	input := string(data)
+
+	tests := []struct {
+		name     string
+		fn       func(string, int, int) (int, error)
+		expected int
+	}{
+		{
+			name: "Part 1",
+			fn: func(input string, startPosition, dialLength int) (int, error) {
+				return Part1(b.Context(), strings.NewReader(input), startPosition, dialLength)
+			},
+			expected: 1180,
+		},
+	}
+
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				result, err := tt.fn(input, startingPosition, dialLength)
+				if err != nil {
+					b.Fatalf("benchmark failed: %v", err)
+				}
+				if result != tt.expected {
+					b.Fatalf("expected %d, got %d", tt.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	input := string(data)
+
+	tests := []struct {
+		name     string
+		fn       func(string, int, int) (int, error)
+		expected int
+	}{
+		{
+			name: "Part 2",
+			fn: func(input string, startPosition, dialLength int) (int, error) {
+				return Part2(b.Context(), strings.NewReader(input), startPosition, dialLength)
+			},
+			expected: 6892,
+		},
+	}
+
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				result, err := tt.fn(input, startingPosition, dialLength)
+				if err != nil {
+					b.Fatalf("benchmark failed: %v", err)
+				}
+				if result != tt.expected {
+					b.Fatalf("expected %d, got %d", tt.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func getInputPath() string {
+	return filepath.Join("..", "..", "cmd", "1", "input.txt")
+}
@@ -0,0 +1,207 @@
+package day01
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type Dial struct {
+	Length   int
+	Position int
+}
+
+func NewDial(length, start int) (Dial, error) {
+	if length <= 0 {
+		return Dial{}, fmt.Errorf("invalid dial length %d", length)
+	}
+	if start < 0 || start >= length {
+		return Dial{}, fmt.Errorf("start position %d is outside a dial of length %d", start, length)
+	}
+	return Dial{Length: length, Position: start}, nil
+}
+
+// ParseDials parses a comma separated list of length:start dials.
+func ParseDials(s string) ([]Dial, error) {
+	fields := strings.Split(s, ",")
+	dials := make([]Dial, 0, len(fields))
+	for _, field := range fields {
+		lengthStr, startStr, found := strings.Cut(strings.TrimSpace(field), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid dial %q, expected length:start", field)
+		}
+		length, err := strconv.Atoi(lengthStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid length in dial %q", field)
+		}
+		start, err := strconv.Atoi(startStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start in dial %q", field)
+		}
+		dial, err := NewDial(length, start)
+		if err != nil {
+			return nil, err
+		}
+		dials = append(dials, dial)
+	}
+	return dials, nil
+}
+
+func (d Dial) String() string {
+	return fmt.Sprintf("%d:%d", d.Length, d.Position)
+}
+
+// Rotate turns the dial by r, which must have been parsed for the length of
+// the dial, and returns how many times it pointed at zero on the way,
+// including where it stopped.
+func (d *Dial) Rotate(r Rotation) (int, error) {
+	position, err := r.Apply(d.Position, d.Length)
+	if err != nil {
+		return 0, err
+	}
+	zeroPasses := CountZeroPasses(d.Position, r, d.Length)
+	d.Position = position
+	return zeroPasses, nil
+}
+
+// CountZeroPasses returns how many of the clicks of rotation, parsed for a dial
+// of the given length, leave the dial pointing at zero when starting from
+// start. Turning right, zero is reached every time start+k is a multiple of
+// length; turning left, every time k ≡ start, counting the clicks k from 1.
+// Each extra rotation passes zero exactly once, so only the remaining steps
+// are summed, which cannot overflow.
+func CountZeroPasses(start int, rotation Rotation, length int) int {
+	if rotation.Direction == DirectionLeft {
+		return rotation.ExtraRotations + ((length-start)%length+rotation.Steps)/length
+	}
+	return rotation.ExtraRotations + (start+rotation.Steps)/length
+}
+
+// CountZeroPassesBruteForce is the click by click reference for
+// CountZeroPasses.
+func CountZeroPassesBruteForce(start int, rotation Rotation, length int) int {
+	delta := 1
+	if rotation.Direction == DirectionLeft {
+		delta = length - 1
+	}
+	zeroPasses := 0
+	position := start
+	for range rotation.ExtraRotations*length + rotation.Steps {
+		position = (position + delta) % length
+		if position == 0 {
+			zeroPasses++
+		}
+	}
+	return zeroPasses
+}
+
+// Step is the effect of one rotation on one dial.
+type Step struct {
+	Dial          int    `json:"dial"`
+	Line          int    `json:"line"`
+	Rotation      string `json:"rotation"`
+	Start         int    `json:"start"`
+	End           int    `json:"end"`
+	ZeroCrossings int    `json:"zero_crossings"`
+}
+
+type Tally struct {
+	EndedAtZero   int
+	ZeroCrossings int
+}
+
+// Simulate applies every rotation of input to each dial independently,
+// calling onStep, when not nil, for every rotation of every dial.
+func Simulate(input io.Reader, dials []Dial, onStep func(Step) error) ([]Tally, error) {
+	dials = append([]Dial(nil), dials...)
+	tallies := make([]Tally, len(dials))
+	scanner := bufio.NewScanner(input)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		for i := range dials {
+			rotation, err := ParseRotation(line, dials[i].Length)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing line %d, '%s', %w", lineNum, line, err)
+			}
+			step := Step{Dial: i, Line: lineNum, Rotation: line, Start: dials[i].Position}
+			if step.ZeroCrossings, err = dials[i].Rotate(rotation); err != nil {
+				return nil, fmt.Errorf("error applying line %d, '%s', %w", lineNum, line, err)
+			}
+			step.End = dials[i].Position
+			tallies[i].ZeroCrossings += step.ZeroCrossings
+			if step.End == 0 {
+				tallies[i].EndedAtZero++
+			}
+			if onStep != nil {
+				if err := onStep(step); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
+	}
+	return tallies, nil
+}
+
+// FindStartPositions returns every start position of a dial of the given
+// length from which rotations, parsed for that length, make part answer
+// targetCount.
+func FindStartPositions(rotations []Rotation, length, targetCount, part int) ([]int, error) {
+	if length <= 0 {
+		return nil, fmt.Errorf("invalid dial length %d", length)
+	}
+	if part != 1 && part != 2 {
+		return nil, fmt.Errorf("invalid part %d", part)
+	}
+	positions := make([]int, 0)
+	for start := range length {
+		dial := Dial{Length: length, Position: start}
+		count := 0
+		for _, r := range rotations {
+			zeroPasses, err := dial.Rotate(r)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case part == 2:
+				count += zeroPasses
+			case dial.Position == 0:
+				count++
+			}
+		}
+		if count == targetCount {
+			positions = append(positions, start)
+		}
+	}
+	return positions, nil
+}
+
+func ParseRotations(lines []string, length int) ([]Rotation, error) {
+	rotations := make([]Rotation, len(lines))
+	for i, line := range lines {
+		rotation, err := ParseRotation(line, length)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing line %d, '%s', %w", i+1, line, err)
+		}
+		rotations[i] = rotation
+	}
+	return rotations, nil
+}
+
+func readLines(input io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(input)
+	lines := make([]string, 0)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
+	}
+	return lines, nil
+}
@@ -0,0 +1,176 @@
+package day01
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+// RotationSummary is the effect of a sequence of rotations on a dial of the
+// given length: every start position moves by the same net Offset, while the
+// zeros met on the way depend on the start position and are tabulated for
+// each of them. Summaries compose associatively with Then.
+type RotationSummary struct {
+	Length      int
+	Offset      int
+	EndedAtZero []int
+	ZeroPasses  []int
+}
+
+// IdentitySummary is the summary of no rotations, the identity of Then.
+func IdentitySummary(length int) RotationSummary {
+	return RotationSummary{
+		Length:      length,
+		EndedAtZero: make([]int, length),
+		ZeroPasses:  make([]int, length),
+	}
+}
+
+// Summarize returns the summary of r, parsed for a dial of the given length.
+func Summarize(r Rotation, length int) RotationSummary {
+	s := IdentitySummary(length)
+	s.Offset = r.Steps
+	if r.Direction == DirectionLeft {
+		s.Offset = (length - r.Steps) % length
+	}
+	for start := range length {
+		s.ZeroPasses[start] = CountZeroPasses(start, r, length)
+		if (start+s.Offset)%length == 0 {
+			s.EndedAtZero[start] = 1
+		}
+	}
+	return s
+}
+
+// Then returns the summary of the rotations of s followed by those of next.
+func (s RotationSummary) Then(next RotationSummary) RotationSummary {
+	composed := IdentitySummary(s.Length)
+	composed.Offset = (s.Offset + next.Offset) % s.Length
+	for start := range s.Length {
+		middle := (start + s.Offset) % s.Length
+		composed.EndedAtZero[start] = s.EndedAtZero[start] + next.EndedAtZero[middle]
+		composed.ZeroPasses[start] = s.ZeroPasses[start] + next.ZeroPasses[middle]
+	}
+	return composed
+}
+
+// Tally returns where a dial starting at start ends and the zeros it meets.
+func (s RotationSummary) Tally(start int) (int, Tally) {
+	return (start + s.Offset) % s.Length, Tally{EndedAtZero: s.EndedAtZero[start], ZeroCrossings: s.ZeroPasses[start]}
+}
+
+// SummarizeAll composes the summaries of rotations, evaluating one contiguous
+// segment per worker and composing the segments in order.
+func SummarizeAll(ctx context.Context, rotations []Rotation, length, workers int) (RotationSummary, error) {
+	if length <= 0 {
+		return RotationSummary{}, fmt.Errorf("invalid dial length %d", length)
+	}
+	segments := min(workpool.Workers(workers), max(len(rotations), 1))
+	segmentSize := (len(rotations) + segments - 1) / segments
+	summaries := make([]RotationSummary, segments)
+	err := workpool.ForEachChunk(ctx, segments, workers, func(ctx context.Context, start, end int) error {
+		for i := start; i < end; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			segment := rotations[min(i*segmentSize, len(rotations)):min((i+1)*segmentSize, len(rotations))]
+			summary, err := summarizeSegment(segment, length)
+			if err != nil {
+				return err
+			}
+			summaries[i] = summary
+		}
+		return nil
+	})
+	if err != nil {
+		return RotationSummary{}, err
+	}
+	total := IdentitySummary(length)
+	for _, s := range summaries {
+		total = total.Then(s)
+	}
+	return total, nil
+}
+
+// summarizeSegment walks rotations from every start position at once, which
+// is equivalent to composing their summaries without allocating them.
+func summarizeSegment(rotations []Rotation, length int) (RotationSummary, error) {
+	s := IdentitySummary(length)
+	positions := make([]int, length)
+	for start := range positions {
+		positions[start] = start
+	}
+	for _, r := range rotations {
+		for start, position := range positions {
+			next, err := r.Apply(position, length)
+			if err != nil {
+				return RotationSummary{}, err
+			}
+			s.ZeroPasses[start] += CountZeroPasses(position, r, length)
+			positions[start] = next
+			if positions[start] == 0 {
+				s.EndedAtZero[start]++
+			}
+		}
+	}
+	s.Offset = positions[0]
+	return s, nil
+}
+
+// SummaryTree is a segment tree of rotation summaries answering range queries
+// in O(log n) compositions.
+type SummaryTree struct {
+	length int
+	n      int
+	nodes  []RotationSummary
+}
+
+func NewSummaryTree(rotations []Rotation, length int) *SummaryTree {
+	t := &SummaryTree{length: length, n: len(rotations), nodes: make([]RotationSummary, 2*len(rotations))}
+	for i, r := range rotations {
+		t.nodes[t.n+i] = Summarize(r, length)
+	}
+	for i := t.n - 1; i > 0; i-- {
+		t.nodes[i] = t.nodes[2*i].Then(t.nodes[2*i+1])
+	}
+	return t
+}
+
+// Query returns the summary of the rotations with index in [i, j).
+func (t *SummaryTree) Query(i, j int) (RotationSummary, error) {
+	if i < 0 || j > t.n || i > j {
+		return RotationSummary{}, fmt.Errorf("invalid range [%d, %d) of %d rotations", i, j, t.n)
+	}
+	left, right := IdentitySummary(t.length), IdentitySummary(t.length)
+	for i, j = i+t.n, j+t.n; i < j; i, j = i/2, j/2 {
+		if i%2 == 1 {
+			left = left.Then(t.nodes[i])
+			i++
+		}
+		if j%2 == 1 {
+			j--
+			right = t.nodes[j].Then(right)
+		}
+	}
+	return left.Then(right), nil
+}
+
+// ZerosBetween returns the zeros met by a dial that started at start before
+// the first rotation, over the rotations with index in [i, j).
+func (t *SummaryTree) ZerosBetween(start, i, j int) (Tally, error) {
+	if start < 0 || start >= t.length {
+		return Tally{}, fmt.Errorf("start position %d is outside a dial of length %d", start, t.length)
+	}
+	before, err := t.Query(0, i)
+	if err != nil {
+		return Tally{}, err
+	}
+	between, err := t.Query(i, j)
+	if err != nil {
+		return Tally{}, err
+	}
+	position, _ := before.Tally(start)
+	_, tally := between.Tally(position)
+	return tally, nil
+}
@@ -0,0 +1,68 @@
+package day01
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	TraceCSV  = "csv"
+	TraceJSON = "json"
+)
+
+type TraceWriter interface {
+	WriteStep(s Step) error
+	Flush() error
+}
+
+// NewTraceWriter returns a writer for format. CSV output starts with a header
+// row, JSON output emits one record per line.
+func NewTraceWriter(w io.Writer, format string) (TraceWriter, error) {
+	switch format {
+	case TraceCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"dial", "line", "rotation", "start", "end", "zero_crossings"}); err != nil {
+			return nil, err
+		}
+		return csvTraceWriter{cw}, nil
+	case TraceJSON:
+		return jsonTraceWriter{json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid trace format %q, expected %s|%s", format, TraceCSV, TraceJSON)
+	}
+}
+
+type csvTraceWriter struct {
+	w *csv.Writer
+}
+
+func (c csvTraceWriter) WriteStep(s Step) error {
+	return c.w.Write([]string{
+		strconv.Itoa(s.Dial),
+		strconv.Itoa(s.Line),
+		s.Rotation,
+		strconv.Itoa(s.Start),
+		strconv.Itoa(s.End),
+		strconv.Itoa(s.ZeroCrossings),
+	})
+}
+
+func (c csvTraceWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonTraceWriter struct {
+	enc *json.Encoder
+}
+
+func (j jsonTraceWriter) WriteStep(s Step) error {
+	return j.enc.Encode(s)
+}
+
+func (j jsonTraceWriter) Flush() error {
+	return nil
+}
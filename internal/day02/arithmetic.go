@@ -1,17 +1,4 @@
-package main
-
-var evenDigitBoundaries = []Span{
-	{10, 99},
-	{1_000, 9_999},
-	{100_000, 999_999},
-	{10_000_000, 99_999_999},
-	{1_000_000_000, 9_999_999_999},
-	{100_000_000_000, 999_999_999_999},
-	{10_000_000_000_000, 99_999_999_999_999},
-	{1_000_000_000_000_000, 9_999_999_999_999_999},
-	{100_000_000_000_000_000, 999_999_999_999_999_999},
-	// 10^20 does not fit in int64
-}
+package day02
 
 func (r Span) GetInvalidIdsPart1() []int {
 	estimatedCapacity := max(4, (r.End-r.Start)/1_000)
@@ -0,0 +1,117 @@
+package day02
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+// BigSpan is a Span of ids of any length.
+type BigSpan struct {
+	Start *big.Int
+	End   *big.Int
+}
+
+func ParseBigSpan(s string) (BigSpan, error) {
+	before, after, found := strings.Cut(s, "-")
+	if !found {
+		return BigSpan{}, fmt.Errorf("invalid span string: %s", s)
+	}
+	start, ok := new(big.Int).SetString(before, 10)
+	if !ok {
+		return BigSpan{}, fmt.Errorf("invalid start in span string: %s", s)
+	}
+	end, ok := new(big.Int).SetString(after, 10)
+	if !ok {
+		return BigSpan{}, fmt.Errorf("invalid end in span string: %s", s)
+	}
+	if start.Sign() <= 0 || end.Sign() <= 0 || end.Cmp(start) < 0 {
+		return BigSpan{}, fmt.Errorf("invalid span range in span string: %s", s)
+	}
+	return BigSpan{Start: start, End: end}, nil
+}
+
+// SumInvalidIdsPart1 is Span.SumInvalidIdsPart1 for ids of any length.
+func (r BigSpan) SumInvalidIdsPart1() *big.Int {
+	sum := new(big.Int)
+	for numDigits := countBigDigits(r.Start); numDigits <= countBigDigits(r.End); numDigits++ {
+		if numDigits%2 == 0 {
+			sum.Add(sum, r.sumRepeated(numDigits, 2))
+		}
+	}
+	return sum
+}
+
+// SumInvalidIdsPart2 is Span.SumInvalidIdsPart2 for ids of any length.
+func (r BigSpan) SumInvalidIdsPart2() *big.Int {
+	sum := new(big.Int)
+	for numDigits := countBigDigits(r.Start); numDigits <= countBigDigits(r.End); numDigits++ {
+		for _, numRepeats := range repeatCounts(numDigits) {
+			switch mobius(numRepeats) {
+			case -1:
+				sum.Add(sum, r.sumRepeated(numDigits, numRepeats))
+			case 1:
+				sum.Sub(sum, r.sumRepeated(numDigits, numRepeats))
+			}
+		}
+	}
+	return sum
+}
+
+func (r BigSpan) sumRepeated(numDigits, numRepeats int) *big.Int {
+	patternLen := numDigits / numRepeats
+	base := bigPow10(patternLen)
+	m := new(big.Int)
+	for range numRepeats {
+		m.Mul(m, base).Add(m, bigOne)
+	}
+	patternMin := new(big.Int).Sub(r.Start, bigOne)
+	patternMin.Quo(patternMin, m).Add(patternMin, bigOne)
+	if lowest := bigPow10(patternLen - 1); patternMin.Cmp(lowest) < 0 {
+		patternMin = lowest
+	}
+	patternMax := new(big.Int).Quo(r.End, m)
+	if highest := new(big.Int).Sub(base, bigOne); patternMax.Cmp(highest) > 0 {
+		patternMax = highest
+	}
+	if patternMin.Cmp(patternMax) > 0 {
+		return new(big.Int)
+	}
+	count := new(big.Int).Sub(patternMax, patternMin)
+	count.Add(count, bigOne)
+	sum := new(big.Int).Add(patternMin, patternMax)
+	sum.Mul(sum, count).Rsh(sum, 1)
+	return sum.Mul(sum, m)
+}
+
+var bigOne = big.NewInt(1)
+
+func bigPow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func countBigDigits(n *big.Int) int {
+	return len(n.String())
+}
+
+func processBigSpanSums(ctx context.Context, input io.Reader, spanSum func(BigSpan) *big.Int, workers int) (*big.Int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	sum, err := workpool.MapReduceStream(ctx, workpool.Tokens(input, ','), workers,
+		func(_ context.Context, i int, spanStr string) (*big.Int, error) {
+			span, err := ParseBigSpan(spanStr)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing span on index %d: %w", i, err)
+			}
+			return spanSum(span), nil
+		}, bigsum.Add)
+	if err != nil {
+		return nil, err
+	}
+	return bigsum.Add(sum, nil), nil
+}
@@ -0,0 +1,286 @@
+package day02
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+type Span struct {
+	Start int
+	End   int
+}
+
+func ParseSpan(s string) (Span, error) {
+	before, after, found := strings.Cut(s, "-")
+	if !found {
+		return Span{}, fmt.Errorf("invalid span string: %s", s)
+	}
+	start, err := strconv.Atoi(before)
+	if err != nil {
+		return Span{}, fmt.Errorf("invalid start in span string: %s", s)
+	}
+	end, err := strconv.Atoi(after)
+	if err != nil {
+		return Span{}, fmt.Errorf("invalid end in span string: %s", s)
+	}
+	if start <= 0 || end <= 0 || end < start {
+		return Span{}, fmt.Errorf("invalid span range in span string: %s", s)
+	}
+	return Span{Start: start, End: end}, nil
+}
+
+func processSpans(ctx context.Context, input io.Reader, validator func(Span) []int) (int, error) {
+	return processSpansWithWorkers(ctx, input, validator, workpool.DefaultWorkers)
+}
+
+func processSpansWithWorkers(ctx context.Context, input io.Reader, validator func(Span) []int, workers int) (int, error) {
+	return processSpanSums(ctx, input, sumOf(validator), workers)
+}
+
+func processSpanSums(ctx context.Context, input io.Reader, spanSum func(Span) (int, error), workers int) (int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	return workpool.MapReduceStream(ctx, workpool.Tokens(input, ','), workers,
+		func(_ context.Context, i int, spanStr string) (int, error) {
+			span, err := ParseSpan(spanStr)
+			if err != nil {
+				return 0, fmt.Errorf("error parsing span on index %d: %w", i, err)
+			}
+			return spanSum(span)
+		}, workpool.Sum)
+}
+
+func sumOf(validator func(Span) []int) func(Span) (int, error) {
+	return func(s Span) (int, error) {
+		sum := 0
+		for _, id := range validator(s) {
+			sum += id
+		}
+		return sum, nil
+	}
+}
+
+func spanSumPart1(methodChoice string) func(Span) (int, error) {
+	switch methodChoice {
+	case "arithmetic":
+		return sumOf(Span.GetInvalidIdsPart1)
+	case "formula":
+		return Span.SumInvalidIdsPart1
+	}
+	return sumOf(Span.GetInvalidIdsPart1Direct)
+}
+
+func spanSumPart2(methodChoice string) func(Span) (int, error) {
+	switch methodChoice {
+	case "arithmetic":
+		return sumOf(Span.GetInvalidIdsPart2)
+	case "formula":
+		return Span.SumInvalidIdsPart2
+	}
+	return sumOf(Span.GetInvalidIdsPart2Direct)
+}
+
+func Part1(ctx context.Context, input io.Reader, methodChoice string) (int, error) {
+	return processSpanSums(ctx, input, spanSumPart1(methodChoice), workpool.DefaultWorkers)
+}
+
+func Part2(ctx context.Context, input io.Reader, methodChoice string) (int, error) {
+	return processSpanSums(ctx, input, spanSumPart2(methodChoice), workpool.DefaultWorkers)
+}
+
+// Solver answers with the span sums of Method, or of a custom
+// RepetitionRule given by Repeats and Base. With Dedup set, the spans are
+// normalized first, overlapping spans are reported and the raw totals are
+// kept among the details.
+// With List set, the invalid ids of every span are reported instead, the
+// formula method listing those of the direct one.
+type Solver struct {
+	Method     string
+	Big        bool
+	Repeats    string
+	Base       int
+	Dedup      bool
+	List       bool
+	ListFormat string
+	Workers    int
+
+	report  io.Writer
+	details map[string]int
+}
+
+func NewSolver() *Solver {
+	return &Solver{Method: "arithmetic", Base: 10, ListFormat: ListText, report: io.Discard}
+}
+
+func (s *Solver) SetReport(w io.Writer) {
+	s.report = w
+}
+
+func (s *Solver) Details() map[string]int {
+	return s.details
+}
+
+func (s *Solver) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&s.Method, "method", s.Method, "validation method: arithmetic|direct|formula")
+	fs.BoolVar(&s.Big, "big", s.Big, "sum ids of any length with arbitrary precision, requires --method formula")
+	fs.StringVar(&s.Repeats, "repeats", s.Repeats, "repeat counts of both parts: N|N,M,...|N-M|N-, other than 2 requiring --method arithmetic (default exactly 2 for part 1, at least 2 for part 2)")
+	fs.IntVar(&s.Base, "base", s.Base, "base of the ids' digits, 2 to 36, other than 10 requiring --method arithmetic")
+	fs.BoolVar(&s.Dedup, "dedup", s.Dedup, "merge overlapping and adjacent spans first, warning about overlaps and adding raw totals to the results")
+	fs.BoolVar(&s.List, "list", s.List, "report the invalid ids, count and subtotal of every span in input order")
+	fs.StringVar(&s.ListFormat, "list-format", s.ListFormat, "list format: "+ListText+"|"+ListJSON)
+}
+
+// rulePart returns the part whose ids part sums: Repeats applies to both
+// parts, so with 2 repeats part 2 sums those of part 1.
+func (s *Solver) rulePart(part int) int {
+	if part == 2 && s.Repeats == "2" {
+		return 1
+	}
+	return part
+}
+
+// rule returns the repetition rule of part and whether it differs from the
+// decimal defaults, which only the arithmetic method supports.
+func (s *Solver) rule(part int) (RepetitionRule, bool, error) {
+	rule, custom := Part1Rule, false
+	switch {
+	case s.Repeats != "" && s.Repeats != "2":
+		var err error
+		if rule, err = ParseRepeats(s.Repeats); err != nil {
+			return RepetitionRule{}, false, err
+		}
+		custom = true
+	case s.rulePart(part) == 2:
+		rule = Part2Rule
+	}
+	if s.Base != 0 && s.Base != 10 {
+		rule.Base, custom = s.Base, true
+	}
+	if err := rule.Validate(); err != nil {
+		return RepetitionRule{}, false, err
+	}
+	if custom && s.Method != "arithmetic" {
+		return RepetitionRule{}, false, fmt.Errorf("--repeats and --base require --method arithmetic, got %s", s.Method)
+	}
+	return rule, custom, nil
+}
+
+func (s *Solver) UseBig() bool {
+	return s.Big
+}
+
+func (s *Solver) BigPart(ctx context.Context, part int, input io.Reader) (*big.Int, error) {
+	if s.Method != "formula" {
+		return nil, fmt.Errorf("--big requires --method formula, got %s", s.Method)
+	}
+	if s.Dedup || s.List {
+		return nil, errors.New("--dedup and --list are not supported with --big")
+	}
+	if _, _, err := s.rule(part); err != nil {
+		return nil, err
+	}
+	switch s.rulePart(part) {
+	case 1:
+		return processBigSpanSums(ctx, input, BigSpan.SumInvalidIdsPart1, s.Workers)
+	case 2:
+		return processBigSpanSums(ctx, input, BigSpan.SumInvalidIdsPart2, s.Workers)
+	default:
+		return nil, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+// smallPart solves part with arbitrary precision for callers expecting an
+// int, failing when the answer does not fit.
+func (s *Solver) smallPart(ctx context.Context, part int, input io.Reader) (int, error) {
+	answer, err := s.BigPart(ctx, part, input)
+	if err != nil {
+		return 0, err
+	}
+	result, err := bigsum.Int(answer)
+	if err != nil {
+		return 0, fmt.Errorf("answer %w", err)
+	}
+	return result, nil
+}
+
+func (s *Solver) SetWorkers(n int) {
+	s.Workers = n
+}
+
+func (s *Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return s.solve(ctx, 1, input)
+}
+
+func (s *Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return s.solve(ctx, 2, input)
+}
+
+func (s *Solver) solve(ctx context.Context, part int, input io.Reader) (int, error) {
+	s.details = nil
+	if s.Big {
+		return s.smallPart(ctx, part, input)
+	}
+	if err := validateMethod(s.Method); err != nil {
+		return 0, err
+	}
+	rule, custom, err := s.rule(part)
+	if err != nil {
+		return 0, err
+	}
+	spanSum := spanSumPart1(s.Method)
+	if s.rulePart(part) == 2 {
+		spanSum = spanSumPart2(s.Method)
+	}
+	if custom {
+		spanSum = sumOf(rule.InvalidIds)
+	}
+	if s.List {
+		return s.list(ctx, input, part, rule, custom)
+	}
+	if s.Dedup {
+		return s.dedup(ctx, input, part, spanSum)
+	}
+	return processSpanSums(ctx, input, spanSum, s.Workers)
+}
+
+func (s *Solver) list(ctx context.Context, input io.Reader, part int, rule RepetitionRule, custom bool) (int, error) {
+	if s.Dedup {
+		return 0, errors.New("--list is not supported with --dedup")
+	}
+	lw, err := NewListWriter(s.report, s.ListFormat)
+	if err != nil {
+		return 0, err
+	}
+	validator := Span.GetInvalidIdsPart1Direct
+	switch rulePart := s.rulePart(part); {
+	case custom:
+		validator = rule.InvalidIds
+	case s.Method == "arithmetic" && rulePart == 1:
+		validator = Span.GetInvalidIdsPart1
+	case s.Method == "arithmetic":
+		validator = Span.GetInvalidIdsPart2
+	case rulePart == 2:
+		validator = Span.GetInvalidIdsPart2Direct
+	}
+	return listSpans(ctx, input, part, validator, lw, s.Workers)
+}
+
+func validateMethod(method string) error {
+	if method != "arithmetic" && method != "direct" && method != "formula" {
+		return fmt.Errorf("invalid method choice: %s", method)
+	}
+	return nil
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 2, NewSolver: func() aoc.Solver { return NewSolver() }})
+}
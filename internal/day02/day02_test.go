@@ -0,0 +1,833 @@
+package day02
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+	"math"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+func TestGetInvalidIdsPart1(t *testing.T) {
+	methods := []struct {
+		name string
+		fn   func(Span) []int
+	}{
+		{
+			"Arithmetic",
+			Span.GetInvalidIdsPart1,
+		},
+		{
+			"Direct",
+			Span.GetInvalidIdsPart1Direct,
+		},
+	}
+	tests := []struct {
+		name     string
+		expected []int
+	}{
+		{
+			"11-22",
+			[]int{11, 22},
+		},
+		{
+			"95-115",
+			[]int{99},
+		},
+		{
+			"998-1012",
+			[]int{1010},
+		},
+		{
+			"1188511880-1188511890",
+			[]int{1188511885},
+		},
+		{
+			"222220-222224",
+			[]int{222222},
+		},
+		{
+			"1698522-1698528",
+			[]int{},
+		},
+		{
+			"446443-446449",
+			[]int{446446},
+		},
+		{
+			"38593856-38593862",
+			[]int{38593859},
+		},
+		{
+			"565653-565659",
+			[]int{},
+		},
+		{
+			"824824821-824824827",
+			[]int{},
+		},
+		{
+			"2121212118-2121212124",
+			[]int{},
+		},
+	}
+	for _, method := range methods {
+		for _, tt := range tests {
+			t.Run(
+				fmt.Sprintf("%s/%s", method.name, tt.name),
+				func(t *testing.T) {
+					span, err := ParseSpan(tt.name)
+					if err != nil {
+						t.Fatalf("ParseSpan error = %v", err)
+					}
+					result := method.fn(span)
+					if len(result) != len(tt.expected) {
+						t.Fatalf("GetInvalidIds unexpected length, got = %v, want %v", result, tt.expected)
+					}
+					for i := range result {
+						if result[i] != tt.expected[i] {
+							t.Errorf("GetInvalidIds[%d] got = %v, want %v", i, result[i], tt.expected[i])
+						}
+					}
+				})
+		}
+	}
+}
+
+func TestPart1(t *testing.T) {
+	methods := []struct {
+		name string
+		fn   func(io.Reader) (int, error)
+	}{
+		{
+			"Arithmetic",
+			func(i io.Reader) (int, error) {
+				return processSpansWithWorkers(t.Context(), i, Span.GetInvalidIdsPart1, workpool.DefaultWorkers)
+			},
+		},
+		{
+			"Direct",
+			func(i io.Reader) (int, error) {
+				return processSpansWithWorkers(t.Context(), i, Span.GetInvalidIdsPart1Direct, workpool.DefaultWorkers)
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{
+			"Provided example",
+			`11-22,95-115,998-1012,1188511880-1188511890,222220-222224,1698522-1698528,446443-446449,38593856-38593862,565653-565659,824824821-824824827,2121212118-2121212124`,
+			1227775554,
+		},
+	}
+	for _, method := range methods {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", method.name, tt.name),
+				func(t *testing.T) {
+					result, err := method.fn(strings.NewReader(tt.input))
+					if err != nil {
+						t.Fatalf("Part 1 error = %v", err)
+					}
+					if result != tt.expected {
+						t.Errorf("Part 1 got = %v, want %v", result, tt.expected)
+					}
+				})
+		}
+	}
+}
+
+func TestGetInvalidIdsPart2(t *testing.T) {
+	methods := []struct {
+		name string
+		fn   func(Span) []int
+	}{
+		{
+			"Arithmetic",
+			Span.GetInvalidIdsPart2,
+		},
+		{
+			"Direct",
+			Span.GetInvalidIdsPart2Direct,
+		},
+	}
+	tests := []struct {
+		name     string
+		expected []int
+	}{
+		{
+			"11-22",
+			[]int{11, 22},
+		},
+		{
+			"95-115",
+			[]int{99, 111},
+		},
+		{
+			"998-1012",
+			[]int{999, 1010},
+		},
+		{
+			"1188511880-1188511890",
+			[]int{1188511885},
+		},
+		{
+			"222220-222224",
+			[]int{222222},
+		},
+		{
+			"1698522-1698528",
+			[]int{},
+		},
+		{
+			"446443-446449",
+			[]int{446446},
+		},
+		{
+			"38593856-38593862",
+			[]int{38593859},
+		},
+		{
+			"565653-565659",
+			[]int{565656},
+		},
+		{
+			"824824821-824824827",
+			[]int{824824824},
+		},
+		{
+			"2121212118-2121212124",
+			[]int{2121212121},
+		},
+	}
+	for _, method := range methods {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", method.name, tt.name), func(t *testing.T) {
+				span, err := ParseSpan(tt.name)
+				if err != nil {
+					t.Fatalf("ParseSpan error = %v", err)
+				}
+				result := method.fn(span)
+				if len(result) != len(tt.expected) {
+					t.Fatalf("GetInvalidIds unexpected length, got = %v, want %v", result, tt.expected)
+				}
+				for i := range result {
+					if result[i] != tt.expected[i] {
+						t.Errorf("GetInvalidIds[%d] got = %v, want %v", i, result[i], tt.expected[i])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestPart2(t *testing.T) {
+	methods := []struct {
+		name string
+		fn   func(io.Reader) (int, error)
+	}{
+		{
+			"Arithmetic",
+			func(i io.Reader) (int, error) {
+				return processSpansWithWorkers(t.Context(), i, Span.GetInvalidIdsPart2, workpool.DefaultWorkers)
+			},
+		},
+		{
+			"Direct",
+			func(i io.Reader) (int, error) {
+				return processSpansWithWorkers(t.Context(), i, Span.GetInvalidIdsPart2Direct, workpool.DefaultWorkers)
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{
+			"Provided example",
+			`11-22,95-115,998-1012,1188511880-1188511890,222220-222224,1698522-1698528,446443-446449,38593856-38593862,565653-565659,824824821-824824827,2121212118-2121212124`,
+			4174379265,
+		},
+	}
+
+	for _, method := range methods {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", method.name, tt.name), func(t *testing.T) {
+				result, err := method.fn(strings.NewReader(tt.input))
+				if err != nil {
+					t.Fatalf("Part 2 error = %v", err)
+				}
+				if result != tt.expected {
+					t.Errorf("Part 2 got = %v, want %v", result, tt.expected)
+				}
+			})
+		}
+	}
+}
+
+func TestInvalidIdsFrom(t *testing.T) {
+	generators := []struct {
+		name      string
+		ids       func(int) iter.Seq[int]
+		isInvalid func(int) bool
+	}{
+		{"Part1", InvalidIdsPart1From, isInvalidPart1},
+		{"Part2", InvalidIdsPart2From, isInvalidPart2},
+	}
+	r := rand.New(rand.NewSource(1))
+	froms := []int{-5, 0, 1, 10, 99, 100, 999_999, 1_000_000}
+	for range 20 {
+		froms = append(froms, r.Intn(1_000_000))
+	}
+	for _, g := range generators {
+		for _, from := range froms {
+			expected := make([]int, 0, 20)
+			for id := max(from, 1); len(expected) < cap(expected); id++ {
+				if g.isInvalid(id) {
+					expected = append(expected, id)
+				}
+			}
+			got := slices.Collect(func(yield func(int) bool) {
+				i := 0
+				for id := range g.ids(from) {
+					if i == len(expected) || !yield(id) {
+						return
+					}
+					i++
+				}
+			})
+			if !slices.Equal(got, expected) {
+				t.Errorf("%s from %d = %v, want %v", g.name, from, got, expected)
+			}
+		}
+	}
+
+	if got := slices.Collect(InvalidIdsPart2From(8_888_888_888_888_888_000)); !slices.Equal(got, []int{8_888_888_888_888_888_888}) {
+		t.Errorf("InvalidIdsPart2From() near the largest int = %v", got)
+	}
+	if got := slices.Collect(InvalidIdsPart1From(1_000_000_000_000_000_000)); len(got) != 0 {
+		t.Errorf("InvalidIdsPart1From() beyond the largest int = %v", got)
+	}
+}
+
+func TestSumInvalidIds(t *testing.T) {
+	sumRange := func(invalids func(Span) []int, span Span) int {
+		sum := 0
+		for _, id := range invalids(span) {
+			sum += id
+		}
+		return sum
+	}
+	r := rand.New(rand.NewSource(1))
+	spans := []Span{{1, 9}, {1, 9_999_999_999}, {11, 11}, {1_000_000, 9_999_999}}
+	for range 1_000 {
+		start := 1 + r.Intn(9_999_999_999)
+		spans = append(spans, Span{start, min(start+r.Intn(1_000_000), 9_999_999_999)})
+	}
+	for _, span := range spans {
+		part1, err := span.SumInvalidIdsPart1()
+		if err != nil {
+			t.Fatalf("SumInvalidIdsPart1(%v) error = %v", span, err)
+		}
+		if expected := sumRange(Span.GetInvalidIdsPart1Direct, span); part1 != expected {
+			t.Errorf("SumInvalidIdsPart1(%v) = %v, want %v", span, part1, expected)
+		}
+		part2, err := span.SumInvalidIdsPart2()
+		if err != nil {
+			t.Fatalf("SumInvalidIdsPart2(%v) error = %v", span, err)
+		}
+		if expected := sumRange(Span.GetInvalidIdsPart2Direct, span); part2 != expected {
+			t.Errorf("SumInvalidIdsPart2(%v) = %v, want %v", span, part2, expected)
+		}
+	}
+}
+
+func TestSumInvalidIdsLargeSpans(t *testing.T) {
+	tests := []struct {
+		span          Span
+		expected      int
+		errorExpected bool
+	}{
+		{Span{123_456_789_012, 123_456_789_999}, 0, false},
+		{Span{11_000_000_000_000_000, 12_000_000_000_000_000}, 11_111_111_111_111_111, false},
+		{Span{1_000_000_000_000_000, 2_000_000_000_000_000}, 0, true},
+		{Span{1, 1<<63 - 1}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d-%d", tt.span.Start, tt.span.End), func(t *testing.T) {
+			part2, err := tt.span.SumInvalidIdsPart2()
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("SumInvalidIdsPart2() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if part2 != tt.expected {
+				t.Errorf("SumInvalidIdsPart2() = %v, want %v", part2, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSumInvalidIdsBig(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 1_000 {
+		start := 1 + r.Int63n(1<<62)
+		span := Span{int(start), int(start + r.Int63n(1<<62))}
+		bigSpan := BigSpan{big.NewInt(int64(span.Start)), big.NewInt(int64(span.End))}
+		if part1, err := span.SumInvalidIdsPart1(); err == nil && bigSpan.SumInvalidIdsPart1().Cmp(big.NewInt(int64(part1))) != 0 {
+			t.Errorf("BigSpan.SumInvalidIdsPart1(%v) = %v, want %v", span, bigSpan.SumInvalidIdsPart1(), part1)
+		}
+		if part2, err := span.SumInvalidIdsPart2(); err == nil && bigSpan.SumInvalidIdsPart2().Cmp(big.NewInt(int64(part2))) != 0 {
+			t.Errorf("BigSpan.SumInvalidIdsPart2(%v) = %v, want %v", span, bigSpan.SumInvalidIdsPart2(), part2)
+		}
+	}
+
+	tests := []struct {
+		span      string
+		expected1 string
+		expected2 string
+	}{
+		{"1-99", "495", "495"},
+		{"11111111111111111111-11111111111111111111", "11111111111111111111", "11111111111111111111"},
+		{"123123123123123123123-123123123123123123123", "0", "123123123123123123123"},
+		{"10000000000000000000-10000000001000000000", "10000000001000000000", "10000000001000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.span, func(t *testing.T) {
+			span, err := ParseBigSpan(tt.span)
+			if err != nil {
+				t.Fatalf("ParseBigSpan() error = %v", err)
+			}
+			if part1 := span.SumInvalidIdsPart1().String(); part1 != tt.expected1 {
+				t.Errorf("SumInvalidIdsPart1() = %v, want %v", part1, tt.expected1)
+			}
+			if part2 := span.SumInvalidIdsPart2().String(); part2 != tt.expected2 {
+				t.Errorf("SumInvalidIdsPart2() = %v, want %v", part2, tt.expected2)
+			}
+		})
+	}
+}
+
+func TestRepetitionRule(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 100 {
+		start := 1 + r.Intn(9_999_999_999)
+		span := Span{start, start + r.Intn(10_000)}
+		if got, expected := Part1Rule.InvalidIds(span), span.GetInvalidIdsPart1(); !slices.Equal(got, expected) {
+			t.Errorf("Part1Rule.InvalidIds(%v) = %v, want %v", span, got, expected)
+		}
+		if got, expected := Part2Rule.InvalidIds(span), span.GetInvalidIdsPart2(); !slices.Equal(got, expected) {
+			t.Errorf("Part2Rule.InvalidIds(%v) = %v, want %v", span, got, expected)
+		}
+	}
+
+	hexThrice := RepetitionRule{Repeats: []int{3}, Base: 16}
+	tests := []struct {
+		rule     RepetitionRule
+		id       int
+		expected bool
+	}{
+		{hexThrice, 0x111, true},
+		{hexThrice, 0xabcabcabc, true},
+		{hexThrice, 0x111111, true},
+		{hexThrice, 0x1111, false},
+		{hexThrice, 111, false},
+		{RepetitionRule{MinRepeats: 2, MaxRepeats: 3, Base: 2}, 0b1010, true},
+		{RepetitionRule{MinRepeats: 2, MaxRepeats: 3, Base: 2}, 0b1111, true},
+		{RepetitionRule{MinRepeats: 3, MaxRepeats: 3, Base: 2}, 0b1010, false},
+		{RepetitionRule{MinRepeats: 4, Base: 36}, 36*36*36 + 36*36 + 36 + 1, true},
+	}
+	for _, tt := range tests {
+		if got := tt.rule.Matches(tt.id); got != tt.expected {
+			t.Errorf("%+v.Matches(%d) = %v, want %v", tt.rule, tt.id, got, tt.expected)
+		}
+	}
+}
+
+func TestParseRepeats(t *testing.T) {
+	tests := []struct {
+		spec          string
+		expected      RepetitionRule
+		errorExpected bool
+	}{
+		{"3", RepetitionRule{Repeats: []int{3}, Base: 10}, false},
+		{"2,5", RepetitionRule{Repeats: []int{2, 5}, Base: 10}, false},
+		{"2-4", RepetitionRule{MinRepeats: 2, MaxRepeats: 4, Base: 10}, false},
+		{"3-", RepetitionRule{MinRepeats: 3, Base: 10}, false},
+		{"1", RepetitionRule{}, true},
+		{"4-2", RepetitionRule{}, true},
+		{"x", RepetitionRule{}, true},
+		{"-3", RepetitionRule{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			rule, err := ParseRepeats(tt.spec)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("ParseRepeats() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if !tt.errorExpected && !reflect.DeepEqual(rule, tt.expected) {
+				t.Errorf("ParseRepeats() = %+v, want %+v", rule, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProcessSpansErrors(t *testing.T) {
+	cancelled, cancel := context.WithCancel(t.Context())
+	cancel()
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    string
+		expected string
+	}{
+		{
+			"Invalid span",
+			t.Context(),
+			"11-22,95-115,abc,998-1012",
+			"error parsing span on index 2",
+		},
+		{
+			"Reversed span",
+			t.Context(),
+			"11-22,115-95",
+			"error parsing span on index 1",
+		},
+		{
+			"Cancelled context",
+			cancelled,
+			"11-22,95-115",
+			context.Canceled.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := processSpansWithWorkers(tt.ctx, strings.NewReader(tt.input), Span.GetInvalidIdsPart2, workpool.DefaultWorkers)
+			if err == nil {
+				t.Fatalf("processSpansWithWorkers() expected error containing %q", tt.expected)
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("processSpansWithWorkers() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSolver(t *testing.T) {
+	input := `11-22,95-115,998-1012,1188511880-1188511890,222220-222224,1698522-1698528,446443-446449,38593856-38593862,565653-565659,824824821-824824827,2121212118-2121212124`
+	tests := []struct {
+		method        string
+		expected1     int
+		expected2     int
+		errorExpected bool
+	}{
+		{"arithmetic", 1227775554, 4174379265, false},
+		{"direct", 1227775554, 4174379265, false},
+		{"formula", 1227775554, 4174379265, false},
+		{"unknown", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			solver := Solver{Method: tt.method}
+			big1, bigErr := (&Solver{Method: tt.method, Big: true}).Part1(t.Context(), strings.NewReader(input))
+			if (bigErr != nil) != (tt.errorExpected || tt.method != "formula") {
+				t.Fatalf("Solver.Part1() with Big error = %v", bigErr)
+			}
+			if bigErr == nil && big1 != tt.expected1 {
+				t.Errorf("Solver.Part1() with Big got = %v, want %v", big1, tt.expected1)
+			}
+			result1, err := solver.Part1(t.Context(), strings.NewReader(input))
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("Solver.Part1() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			result2, err := solver.Part2(t.Context(), strings.NewReader(input))
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("Solver.Part2() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if result1 != tt.expected1 {
+				t.Errorf("Solver.Part1() got = %v, want %v", result1, tt.expected1)
+			}
+			if result2 != tt.expected2 {
+				t.Errorf("Solver.Part2() got = %v, want %v", result2, tt.expected2)
+			}
+		})
+	}
+}
+
+func TestSolverBig(t *testing.T) {
+	input := "11-22,99999999999999999999-100000000000000000000000"
+	solver := Solver{Method: "formula", Big: true}
+	if _, err := solver.Part1(t.Context(), strings.NewReader(input)); err == nil {
+		t.Errorf("Solver.Part1() expected overflow error")
+	}
+	answer, err := solver.BigPart(t.Context(), 1, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Solver.BigPart() error = %v", err)
+	}
+	// 11 + 22, 20 nines and every 22 digit id made of an 11 digit pattern.
+	expected, _ := new(big.Int).SetString("495000000000549999999955000000032", 10)
+	if answer.Cmp(expected) != 0 {
+		t.Errorf("Solver.BigPart() = %v, want %v", answer, expected)
+	}
+}
+
+func TestSolverRule(t *testing.T) {
+	tests := []struct {
+		name          string
+		solver        Solver
+		input         string
+		expected1     int
+		expected2     int
+		errorExpected bool
+	}{
+		{"Thrice", Solver{Method: "arithmetic", Repeats: "3"}, "100-1000,1010-1010", 4995, 4995, false},
+		{"Twice", Solver{Method: "formula", Repeats: "2"}, "95-115,998-1012", 99 + 1010, 99 + 1010, false},
+		{"Binary", Solver{Method: "arithmetic", Base: 2}, "1-15", 3 + 10 + 15, 3 + 7 + 10 + 15, false},
+		{"Binary twice", Solver{Method: "arithmetic", Repeats: "2", Base: 2}, "1-15", 3 + 10 + 15, 3 + 10 + 15, false},
+		{"Formula", Solver{Method: "formula", Base: 16}, "1-15", 0, 0, true},
+		{"Invalid base", Solver{Method: "arithmetic", Base: 37}, "1-15", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result1, err := tt.solver.Part1(t.Context(), strings.NewReader(tt.input))
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("Solver.Part1() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			result2, err := tt.solver.Part2(t.Context(), strings.NewReader(tt.input))
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("Solver.Part2() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if result1 != tt.expected1 || result2 != tt.expected2 {
+				t.Errorf("Solver parts = %v, %v, want %v, %v", result1, result2, tt.expected1, tt.expected2)
+			}
+		})
+	}
+}
+
+func TestNormalizeSpans(t *testing.T) {
+	spans := []Span{{95, 115}, {11, 22}, {23, 30}, {100, 200}, {11, 22}, {300, 400}, {150, 160}}
+	expected := []Span{{11, 30}, {95, 200}, {300, 400}}
+	if got := NormalizeSpans(spans); !slices.Equal(got, expected) {
+		t.Errorf("NormalizeSpans() = %v, want %v", got, expected)
+	}
+	expectedOverlaps := []Overlap{
+		{IndexedSpan{0, Span{95, 115}}, IndexedSpan{3, Span{100, 200}}},
+		{IndexedSpan{1, Span{11, 22}}, IndexedSpan{4, Span{11, 22}}},
+		{IndexedSpan{3, Span{100, 200}}, IndexedSpan{6, Span{150, 160}}},
+	}
+	if got := FindOverlaps(spans); !slices.Equal(got, expectedOverlaps) {
+		t.Errorf("FindOverlaps() = %v, want %v", got, expectedOverlaps)
+	}
+	maxSpans := []Span{{1, math.MaxInt}, {math.MaxInt, math.MaxInt}}
+	if got, expected := NormalizeSpans(maxSpans), []Span{{1, math.MaxInt}}; !slices.Equal(got, expected) {
+		t.Errorf("NormalizeSpans() = %v, want %v", got, expected)
+	}
+}
+
+func TestSolverDedup(t *testing.T) {
+	solver := NewSolver()
+	solver.Dedup = true
+	report := strings.Builder{}
+	solver.SetReport(&report)
+	result, err := solver.Part1(t.Context(), strings.NewReader("11-22,95-115,15-33,998-1012"))
+	if err != nil {
+		t.Fatalf("Solver.Part1() error = %v", err)
+	}
+	if expected := 11 + 22 + 33 + 99 + 1010; result != expected {
+		t.Errorf("Solver.Part1() = %v, want %v", result, expected)
+	}
+	expectedReport := "warning: part 1: span 0 (11-22) overlaps span 2 (15-33)\n"
+	if report.String() != expectedReport {
+		t.Errorf("Solver.Part1() report = %q, want %q", report.String(), expectedReport)
+	}
+	expectedDetails := map[string]int{"raw_total": 1197, "merged_spans": 3}
+	if !maps.Equal(solver.Details(), expectedDetails) {
+		t.Errorf("Solver.Details() = %v, want %v", solver.Details(), expectedDetails)
+	}
+}
+
+func TestSolverList(t *testing.T) {
+	spans := make([]string, 0, 200)
+	expected := strings.Builder{}
+	for i := range 200 {
+		span := Span{1 + i*1_000, 1_000 + i*1_000}
+		spans = append(spans, fmt.Sprintf("%d-%d", span.Start, span.End))
+		ids := span.GetInvalidIdsPart2()
+		sum := 0
+		for _, id := range ids {
+			sum += id
+		}
+		fmt.Fprintf(&expected, `{"part":2,"index":%d,"start":%d,"end":%d,"invalid_ids":%s,"count":%d,"subtotal":%d}`+"\n",
+			i, span.Start, span.End, strings.ReplaceAll(fmt.Sprint(ids), " ", ","), len(ids), sum)
+	}
+	input := strings.Join(spans, ",")
+
+	for _, method := range []string{"arithmetic", "direct", "formula"} {
+		t.Run(method, func(t *testing.T) {
+			solver := NewSolver()
+			solver.Method, solver.List, solver.ListFormat, solver.Workers = method, true, ListJSON, 8
+			report := strings.Builder{}
+			solver.SetReport(&report)
+			result, err := solver.Part2(t.Context(), strings.NewReader(input))
+			if err != nil {
+				t.Fatalf("Solver.Part2() error = %v", err)
+			}
+			if expected, _ := processSpans(t.Context(), strings.NewReader(input), Span.GetInvalidIdsPart2); result != expected {
+				t.Errorf("Solver.Part2() = %v, want %v", result, expected)
+			}
+			if report.String() != expected.String() {
+				t.Errorf("Solver.Part2() listing = %q, want %q", report.String(), expected.String())
+			}
+		})
+	}
+
+	solver := NewSolver()
+	solver.List = true
+	report := strings.Builder{}
+	solver.SetReport(&report)
+	if _, err := solver.Part1(t.Context(), strings.NewReader("11-22,23-32")); err != nil {
+		t.Fatalf("Solver.Part1() error = %v", err)
+	}
+	expectedText := "part 1 span 0 11-22: 2 invalid ids, subtotal 33: 11,22\npart 1 span 1 23-32: 0 invalid ids, subtotal 0\n"
+	if report.String() != expectedText {
+		t.Errorf("Solver.Part1() listing = %q, want %q", report.String(), expectedText)
+	}
+
+	solver.ListFormat = "xml"
+	if _, err := solver.Part1(t.Context(), strings.NewReader("11-22")); err == nil {
+		t.Errorf("Solver.Part1() expected error for list format xml")
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 2)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	input := string(data)
+	expected := 44487518055
+
+	b.Run("Arithmetic", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := processSpans(b.Context(), strings.NewReader(input), Span.GetInvalidIdsPart1)
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+
+	b.Run("Direct", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := processSpans(b.Context(), strings.NewReader(input), Span.GetInvalidIdsPart1Direct)
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+
+	b.Run("Formula", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := processSpanSums(b.Context(), strings.NewReader(input), Span.SumInvalidIdsPart1, workpool.DefaultWorkers)
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	input := string(data)
+	expected := 53481866137
+
+	b.Run("Arithmetic", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := processSpans(b.Context(), strings.NewReader(input), Span.GetInvalidIdsPart2)
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+
+	b.Run("Direct", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := processSpans(b.Context(), strings.NewReader(input), Span.GetInvalidIdsPart2Direct)
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+
+	b.Run("Formula", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := processSpanSums(b.Context(), strings.NewReader(input), Span.SumInvalidIdsPart2, workpool.DefaultWorkers)
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+}
+
+func BenchmarkInvalidIdsFrom(b *testing.B) {
+	b.Run("Part1", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = Span{1, 9_999_999_999}.GetInvalidIdsPart1Direct()
+		}
+	})
+
+	b.Run("Part2", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = Span{1, 9_999_999_999}.GetInvalidIdsPart2Direct()
+		}
+	})
+}
+
+func getInputPath() string {
+	return filepath.Join("..", "..", "cmd", "2", "input.txt")
+}
@@ -0,0 +1,90 @@
+package day02
+
+import "iter"
+
+// InvalidIdsPart1From yields the ids made of a pattern repeated twice, in
+// ascending order from the lowest one not below from.
+func InvalidIdsPart1From(from int) iter.Seq[int] {
+	return invalidIdsFrom(from, func(numDigits int) []int {
+		if numDigits%2 != 0 {
+			return nil
+		}
+		return []int{2}
+	})
+}
+
+// InvalidIdsPart2From yields the ids made of a pattern repeated at least
+// twice, in ascending order from the lowest one not below from.
+func InvalidIdsPart2From(from int) iter.Seq[int] {
+	return invalidIdsFrom(from, func(numDigits int) []int {
+		return divisorsTable[numDigits]
+	})
+}
+
+// invalidIdsFrom yields the ids made of a pattern repeated any of the
+// repeatCounts of their length, stopping at the largest one an int holds. The
+// next id is the smallest of the next repeated pattern of every count, so
+// each id is yielded once even when made of several.
+func invalidIdsFrom(from int, repeatCounts func(numDigits int) []int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		next := max(from, 1)
+		for numDigits := countDigits(next); numDigits <= maxIntDigits; numDigits++ {
+			for {
+				id, ok := 0, false
+				for _, numRepeats := range repeatCounts(numDigits) {
+					if candidate, found := nextRepeated(next, numDigits, numRepeats); found && (!ok || candidate < id) {
+						id, ok = candidate, true
+					}
+				}
+				if !ok {
+					break
+				}
+				if !yield(id) {
+					return
+				}
+				next = id + 1
+			}
+			if numDigits < maxIntDigits {
+				next = max(next, pow10Table[numDigits])
+			}
+		}
+	}
+}
+
+// nextRepeated returns the smallest id of numDigits digits not below from made
+// of a pattern repeated numRepeats times, if there is one an int holds.
+func nextRepeated(from, numDigits, numRepeats int) (int, bool) {
+	patternLen := numDigits / numRepeats
+	m := 0
+	for range numRepeats {
+		m = m*pow10Table[patternLen] + 1
+	}
+	pattern := max(pow10Table[patternLen-1], (from-1)/m+1)
+	if pattern >= pow10Table[patternLen] {
+		return 0, false
+	}
+	id, err := mulChecked(pattern, m)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+func (r Span) GetInvalidIdsPart1Direct() []int {
+	return collectUntil(InvalidIdsPart1From(r.Start), r.End)
+}
+
+func (r Span) GetInvalidIdsPart2Direct() []int {
+	return collectUntil(InvalidIdsPart2From(r.Start), r.End)
+}
+
+func collectUntil(ids iter.Seq[int], end int) []int {
+	invalids := make([]int, 0)
+	for id := range ids {
+		if id > end {
+			break
+		}
+		invalids = append(invalids, id)
+	}
+	return invalids
+}
@@ -0,0 +1,116 @@
+package day02
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+)
+
+// SumInvalidIdsPart1 sums the ids of the span made of a pattern repeated
+// twice without enumerating them.
+func (r Span) SumInvalidIdsPart1() (int, error) {
+	sum := 0
+	for numDigits := countDigits(r.Start); numDigits <= countDigits(r.End); numDigits++ {
+		if numDigits%2 != 0 {
+			continue
+		}
+		s, err := r.sumRepeated(numDigits, 2)
+		if err != nil {
+			return 0, err
+		}
+		if sum, err = addChecked(sum, s); err != nil {
+			return 0, fmt.Errorf("sum of invalid ids in span %d-%d: %w", r.Start, r.End, err)
+		}
+	}
+	return sum, nil
+}
+
+// SumInvalidIdsPart2 sums the ids of the span made of a pattern repeated at
+// least twice without enumerating them. An id made of d repeats is also made
+// of e repeats for every divisor e of d, so the sums over the repeat counts
+// of divisorsTable are combined by inclusion–exclusion, the Möbius function
+// of the repeat count weighting each of them.
+func (r Span) SumInvalidIdsPart2() (int, error) {
+	sum := 0
+	for numDigits := countDigits(r.Start); numDigits <= countDigits(r.End); numDigits++ {
+		for _, numRepeats := range divisorsTable[numDigits] {
+			sign := -mobius(numRepeats)
+			if sign == 0 {
+				continue
+			}
+			s, err := r.sumRepeated(numDigits, numRepeats)
+			if err != nil {
+				return 0, err
+			}
+			if sum, err = addChecked(sum, sign*s); err != nil {
+				return 0, fmt.Errorf("sum of invalid ids in span %d-%d: %w", r.Start, r.End, err)
+			}
+		}
+	}
+	return sum, nil
+}
+
+// sumRepeated sums the ids of the span with numDigits digits made of a
+// pattern repeated numRepeats times. Such an id is pattern*m with m being
+// 1 followed by numRepeats-1 groups of zeros and a one, so the patterns in
+// range form an arithmetic series.
+func (r Span) sumRepeated(numDigits, numRepeats int) (int, error) {
+	patternLen := numDigits / numRepeats
+	m := 0
+	for range numRepeats {
+		m = m*pow10Table[patternLen] + 1
+	}
+	patternMin := max(pow10Table[patternLen-1], (r.Start-1)/m+1)
+	patternMax := min(pow10Table[patternLen]-1, r.End/m)
+	if patternMin > patternMax {
+		return 0, nil
+	}
+	a, b := patternMin+patternMax, patternMax-patternMin+1
+	if a%2 == 0 {
+		a /= 2
+	} else {
+		b /= 2
+	}
+	sum, err := mulChecked(a*b, m)
+	if err != nil {
+		return 0, fmt.Errorf("sum of invalid ids in span %d-%d: %w", r.Start, r.End, err)
+	}
+	return sum, nil
+}
+
+// mobius returns the Möbius function of n: 0 when n has a squared prime
+// factor, otherwise -1 or 1 for an odd or even number of prime factors.
+func mobius(n int) int {
+	result := 1
+	for p := 2; p*p <= n; p++ {
+		if n%p != 0 {
+			continue
+		}
+		n /= p
+		if n%p == 0 {
+			return 0
+		}
+		result = -result
+	}
+	if n > 1 {
+		result = -result
+	}
+	return result
+}
+
+func mulChecked(a, b int) (int, error) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi != 0 || lo > 1<<63-1 {
+		return 0, bigsum.ErrOverflow
+	}
+	return int(lo), nil
+}
+
+func addChecked(a, b int) (int, error) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, bigsum.ErrOverflow
+	}
+	return sum, nil
+}
@@ -0,0 +1,106 @@
+package day02
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+const (
+	ListText = "text"
+	ListJSON = "json"
+)
+
+// Listing is the audit record of the invalid ids of one input span.
+type Listing struct {
+	Part       int   `json:"part"`
+	Index      int   `json:"index"`
+	Start      int   `json:"start"`
+	End        int   `json:"end"`
+	InvalidIds []int `json:"invalid_ids"`
+	Count      int   `json:"count"`
+	Subtotal   int   `json:"subtotal"`
+}
+
+type ListWriter interface {
+	WriteListing(l Listing) error
+}
+
+// NewListWriter returns a writer for format, both text and JSON output
+// emitting one record per line.
+func NewListWriter(w io.Writer, format string) (ListWriter, error) {
+	switch format {
+	case ListText:
+		return textListWriter{w}, nil
+	case ListJSON:
+		return jsonListWriter{json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid list format %q, expected %s|%s", format, ListText, ListJSON)
+	}
+}
+
+type textListWriter struct {
+	w io.Writer
+}
+
+func (t textListWriter) WriteListing(l Listing) error {
+	line := fmt.Sprintf("part %d span %d %d-%d: %d invalid ids, subtotal %d", l.Part, l.Index, l.Start, l.End, l.Count, l.Subtotal)
+	if len(l.InvalidIds) > 0 {
+		ids := make([]string, len(l.InvalidIds))
+		for i, id := range l.InvalidIds {
+			ids[i] = strconv.Itoa(id)
+		}
+		line += ": " + strings.Join(ids, ",")
+	}
+	_, err := fmt.Fprintln(t.w, line)
+	return err
+}
+
+type jsonListWriter struct {
+	enc *json.Encoder
+}
+
+func (j jsonListWriter) WriteListing(l Listing) error {
+	return j.enc.Encode(l)
+}
+
+// listSpans sums the invalid ids of the spans of input, writing the listing
+// of every span in input order. Spans are validated in parallel, a listing
+// waiting for those before it to be written.
+func listSpans(ctx context.Context, input io.Reader, part int, validator func(Span) []int, lw ListWriter, workers int) (int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	var mu sync.Mutex
+	pending := make(map[int]Listing)
+	next := 0
+	return workpool.MapReduceStream(ctx, workpool.Tokens(input, ','), workers,
+		func(_ context.Context, i int, spanStr string) (int, error) {
+			span, err := ParseSpan(spanStr)
+			if err != nil {
+				return 0, fmt.Errorf("error parsing span on index %d: %w", i, err)
+			}
+			l := Listing{Part: part, Index: i, Start: span.Start, End: span.End, InvalidIds: validator(span)}
+			l.Count = len(l.InvalidIds)
+			for _, id := range l.InvalidIds {
+				l.Subtotal += id
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			pending[i] = l
+			for ready, ok := pending[next]; ok; ready, ok = pending[next] {
+				if err := lw.WriteListing(ready); err != nil {
+					return 0, err
+				}
+				delete(pending, next)
+				next++
+			}
+			return l.Subtotal, nil
+		}, workpool.Sum)
+}
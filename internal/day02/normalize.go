@@ -0,0 +1,125 @@
+package day02
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+// IndexedSpan is a span along with its position in the input.
+type IndexedSpan struct {
+	Index int
+	Span
+}
+
+// Overlap is a pair of input spans sharing ids, First preceding Second in the
+// input.
+type Overlap struct {
+	First  IndexedSpan
+	Second IndexedSpan
+}
+
+func ParseSpans(input io.Reader) ([]Span, error) {
+	spans := make([]Span, 0)
+	for spanStr, err := range workpool.Tokens(input, ',') {
+		if err != nil {
+			return nil, err
+		}
+		span, err := ParseSpan(spanStr)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing span on index %d: %w", len(spans), err)
+		}
+		spans = append(spans, span)
+	}
+	return spans, nil
+}
+
+// FindOverlaps returns every pair of spans sharing ids, ordered by their
+// positions in the input. The spans are swept by start, each one overlapping
+// those seen before it that have not ended yet.
+func FindOverlaps(spans []Span) []Overlap {
+	sorted := make([]IndexedSpan, len(spans))
+	for i, s := range spans {
+		sorted[i] = IndexedSpan{i, s}
+	}
+	slices.SortStableFunc(sorted, func(a, b IndexedSpan) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	overlaps := make([]Overlap, 0)
+	active := make([]IndexedSpan, 0)
+	for _, s := range sorted {
+		active = slices.DeleteFunc(active, func(a IndexedSpan) bool {
+			return a.End < s.Start
+		})
+		for _, a := range active {
+			if a.Index < s.Index {
+				overlaps = append(overlaps, Overlap{a, s})
+			} else {
+				overlaps = append(overlaps, Overlap{s, a})
+			}
+		}
+		active = append(active, s)
+	}
+	slices.SortFunc(overlaps, func(a, b Overlap) int {
+		return cmp.Or(cmp.Compare(a.First.Index, b.First.Index), cmp.Compare(a.Second.Index, b.Second.Index))
+	})
+	return overlaps
+}
+
+// NormalizeSpans returns the spans covering the same ids as spans, sorted and
+// with overlapping and adjacent ones merged, so that no id is counted twice.
+func NormalizeSpans(spans []Span) []Span {
+	sorted := slices.SortedFunc(slices.Values(spans), func(a, b Span) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	merged := make([]Span, 0, len(sorted))
+	for _, s := range sorted {
+		if last := len(merged) - 1; last >= 0 && s.Start-1 <= merged[last].End {
+			merged[last].End = max(merged[last].End, s.End)
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+func sumSpans(ctx context.Context, spans []Span, spanSum func(Span) (int, error), workers int) (int, error) {
+	return workpool.MapReduce(ctx, spans, workers,
+		func(_ context.Context, _ int, s Span) (int, error) {
+			return spanSum(s)
+		}, workpool.Sum)
+}
+
+// dedup answers part over the normalized spans of input, warning about
+// overlapping spans and keeping the raw total among the details.
+func (s *Solver) dedup(ctx context.Context, input io.Reader, part int, spanSum func(Span) (int, error)) (int, error) {
+	end := aoc.StartPhase(ctx, aoc.PhaseParse)
+	spans, err := ParseSpans(input)
+	end()
+	if err != nil {
+		return 0, err
+	}
+
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	raw, err := sumSpans(ctx, spans, spanSum, s.Workers)
+	if err != nil {
+		return 0, err
+	}
+	merged := NormalizeSpans(spans)
+	total, err := sumSpans(ctx, merged, spanSum, s.Workers)
+	if err != nil {
+		return 0, err
+	}
+	for _, o := range FindOverlaps(spans) {
+		fmt.Fprintf(s.report, "warning: part %d: span %d (%d-%d) overlaps span %d (%d-%d)\n",
+			part, o.First.Index, o.First.Start, o.First.End, o.Second.Index, o.Second.Start, o.Second.End)
+	}
+	s.details = map[string]int{"raw_total": raw, "merged_spans": len(merged)}
+	return total, nil
+}
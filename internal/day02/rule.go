@@ -0,0 +1,122 @@
+package day02
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RepetitionRule describes the invalid ids made of a pattern of digits in Base
+// repeated a number of times. Part 1 looks for patterns repeated exactly
+// twice and part 2 for patterns repeated at least twice, both in decimal.
+type RepetitionRule struct {
+	// Repeats lists the exact repeat counts allowed, taking precedence over
+	// MinRepeats and MaxRepeats when not empty.
+	Repeats    []int
+	MinRepeats int
+	// MaxRepeats of zero leaves the repeat count unbounded.
+	MaxRepeats int
+	Base       int
+}
+
+var (
+	Part1Rule = RepetitionRule{Repeats: []int{2}, Base: 10}
+	Part2Rule = RepetitionRule{MinRepeats: 2, Base: 10}
+)
+
+// ParseRepeats parses the decimal rule allowing the repeat counts of spec,
+// either a count, a comma separated list of counts, a min-max range or an
+// open min- range.
+func ParseRepeats(spec string) (RepetitionRule, error) {
+	rule := RepetitionRule{Base: 10}
+	if minStr, maxStr, found := strings.Cut(spec, "-"); found {
+		minRepeats, err := strconv.Atoi(minStr)
+		if err != nil {
+			return RepetitionRule{}, fmt.Errorf("invalid minimum repeats in %q", spec)
+		}
+		rule.MinRepeats = minRepeats
+		if maxStr != "" {
+			if rule.MaxRepeats, err = strconv.Atoi(maxStr); err != nil {
+				return RepetitionRule{}, fmt.Errorf("invalid maximum repeats in %q", spec)
+			}
+		}
+		return rule, rule.Validate()
+	}
+	for _, field := range strings.Split(spec, ",") {
+		repeats, err := strconv.Atoi(field)
+		if err != nil {
+			return RepetitionRule{}, fmt.Errorf("invalid repeats %q in %q", field, spec)
+		}
+		rule.Repeats = append(rule.Repeats, repeats)
+	}
+	return rule, rule.Validate()
+}
+
+func (r RepetitionRule) Validate() error {
+	if r.Base < 2 || r.Base > 36 {
+		return fmt.Errorf("invalid base %d, expected 2 to 36", r.Base)
+	}
+	if len(r.Repeats) > 0 {
+		for _, repeats := range r.Repeats {
+			if repeats < 2 {
+				return fmt.Errorf("invalid repeat count %d, expected at least 2", repeats)
+			}
+		}
+		return nil
+	}
+	if r.MinRepeats < 2 {
+		return fmt.Errorf("invalid minimum repeats %d, expected at least 2", r.MinRepeats)
+	}
+	if r.MaxRepeats != 0 && r.MaxRepeats < r.MinRepeats {
+		return errors.New("maximum repeats is below minimum repeats")
+	}
+	return nil
+}
+
+func (r RepetitionRule) allows(numRepeats int) bool {
+	if len(r.Repeats) > 0 {
+		return slices.Contains(r.Repeats, numRepeats)
+	}
+	return numRepeats >= r.MinRepeats && (r.MaxRepeats == 0 || numRepeats <= r.MaxRepeats)
+}
+
+// Matches reports whether the digits of id in the base of the rule are a
+// pattern repeated an allowed number of times.
+func (r RepetitionRule) Matches(id int) bool {
+	numDigits := 0
+	for n := id; n > 0; n /= r.Base {
+		numDigits++
+	}
+	for numRepeats := 2; numRepeats <= numDigits; numRepeats++ {
+		if numDigits%numRepeats != 0 || !r.allows(numRepeats) {
+			continue
+		}
+		divisor := 1
+		for range numDigits / numRepeats {
+			divisor *= r.Base
+		}
+		pattern := id % divisor
+		temp := id
+		for temp > 0 && temp%divisor == pattern {
+			temp /= divisor
+		}
+		if temp == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// InvalidIds returns the ids of s matching the rule, the validator of
+// processSpans.
+func (r RepetitionRule) InvalidIds(s Span) []int {
+	invalids := make([]int, 0)
+	for i := s.Start; i <= s.End; i++ {
+		if r.Matches(i) {
+			invalids = append(invalids, i)
+		}
+	}
+	return invalids
+}
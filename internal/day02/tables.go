@@ -0,0 +1,54 @@
+package day02
+
+import "math"
+
+// maxIntDigits is the number of digits of math.MaxInt, the longest ids an int
+// holds.
+var maxIntDigits = countDigits(math.MaxInt)
+
+var (
+	pow10Table          = makePow10Table()
+	divisorsTable       = makeDivisorsTable()
+	evenDigitBoundaries = makeEvenDigitBoundaries()
+)
+
+// makePow10Table returns the powers of ten that fit in an int.
+func makePow10Table() []int {
+	table := []int{1}
+	for p := 1; p <= math.MaxInt/10; p *= 10 {
+		table = append(table, p*10)
+	}
+	return table
+}
+
+// makeDivisorsTable maps every id length that fits in an int to its repeat
+// counts.
+func makeDivisorsTable() map[int][]int {
+	table := make(map[int][]int, maxIntDigits)
+	for numDigits := 2; numDigits <= maxIntDigits; numDigits++ {
+		table[numDigits] = repeatCounts(numDigits)
+	}
+	return table
+}
+
+// repeatCounts returns the divisors of numDigits greater than one, i.e. how
+// many times a pattern may be repeated to form an id of numDigits digits.
+func repeatCounts(numDigits int) []int {
+	counts := make([]int, 0)
+	for d := 2; d <= numDigits; d++ {
+		if numDigits%d == 0 {
+			counts = append(counts, d)
+		}
+	}
+	return counts
+}
+
+// makeEvenDigitBoundaries returns the spans of ids with an even number of
+// digits that fit in an int.
+func makeEvenDigitBoundaries() []Span {
+	boundaries := make([]Span, 0)
+	for numDigits := 2; numDigits < len(pow10Table); numDigits += 2 {
+		boundaries = append(boundaries, Span{pow10Table[numDigits-1], pow10Table[numDigits] - 1})
+	}
+	return boundaries
+}
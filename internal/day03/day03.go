@@ -0,0 +1,258 @@
+package day03
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+const (
+	part1Length = 2
+	part2Length = 12
+	// maxJoltageDigits is the most digits of a joltage an int always holds.
+	maxJoltageDigits = 18
+)
+
+// ParseBankPart1 returns the joltage of the two batteries of input picked for
+// part 1 along with their indices.
+func ParseBankPart1(input string) (int, []int, error) {
+	return parseBank(input, part1Length)
+}
+
+// ParseBankPart2 returns the joltage of the twelve batteries of input picked
+// for part 2 along with their indices.
+func ParseBankPart2(input string) (int, []int, error) {
+	return parseBank(input, part2Length)
+}
+
+func parseBank(input string, k int) (int, []int, error) {
+	indices, err := BankQuery{Digits: k}.Indices(input)
+	if err != nil {
+		return 0, nil, err
+	}
+	return joltageOf(input, indices), indices, nil
+}
+
+// Joltage returns the largest number made of k digits of bank kept in order.
+func Joltage(bank string, k int) (int, error) {
+	return BankQuery{Digits: k}.Joltage(bank)
+}
+
+// BigJoltage returns the largest number made of k digits of bank kept in
+// order, however many digits k is.
+func BigJoltage(bank string, k int) (*big.Int, error) {
+	return BankQuery{Digits: k}.BigJoltage(bank)
+}
+
+// MaxSubsequence returns the k digits of bank, kept in order, forming the
+// largest number.
+func MaxSubsequence(bank string, k int) (string, error) {
+	return BankQuery{Digits: k}.Select(bank)
+}
+
+// appendSubsequence appends the indices of the k digits of bank, kept in
+// order, forming the best number for objective to stack. The digits are pushed
+// in order, a worse digit on top being popped whenever a better one follows
+// while enough digits remain to pick k, so every digit is pushed and popped
+// at most once.
+func appendSubsequence(stack []int, bank string, k int, objective Objective) ([]int, error) {
+	base := len(stack)
+	drops := len(bank) - k
+	// Flipping every bit of both digits reverses their order when minimizing.
+	var flip byte
+	if objective == Minimize {
+		flip = 0xff
+	}
+	for i := range len(bank) {
+		d := bank[i]
+		if !validByte(d) {
+			return nil, fmt.Errorf("invalid digit at index %d: %q", i, d)
+		}
+		for drops > 0 && len(stack) > base && bank[stack[len(stack)-1]]^flip < d^flip {
+			stack = stack[:len(stack)-1]
+			drops--
+		}
+		if len(stack)-base < k {
+			stack = append(stack, i)
+		} else {
+			drops--
+		}
+	}
+	return stack, nil
+}
+
+// joltageOf returns the number made of the digits of bank at indices.
+func joltageOf(bank string, indices []int) int {
+	result := 0
+	for _, i := range indices {
+		result = result*10 + int(bank[i]-'0')
+	}
+	return result
+}
+
+func validByte(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// checkedSum is a sum of joltages, which are never negative, recording
+// whether it overflowed.
+type checkedSum struct {
+	value      int
+	overflowed bool
+}
+
+func addJoltages(a, b checkedSum) checkedSum {
+	sum := a.value + b.value
+	return checkedSum{sum, a.overflowed || b.overflowed || sum < a.value}
+}
+
+func processBanksWithWorkers(ctx context.Context, input io.Reader, parser func(string) (int, error), workers int) (int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	total, err := workpool.MapReduceStream(ctx, workpool.NumberedLines(input), workers,
+		func(_ context.Context, _ int, bank workpool.Line) (checkedSum, error) {
+			result, err := parser(bank.Text)
+			if err != nil {
+				return checkedSum{}, fmt.Errorf("error parsing bank on line %d %q: %w", bank.Number, bank.Text, err)
+			}
+			return checkedSum{value: result}, nil
+		}, addJoltages)
+	if err != nil {
+		return 0, err
+	}
+	if total.overflowed {
+		return 0, fmt.Errorf("total joltage %w", bigsum.ErrOverflow)
+	}
+	return total.value, nil
+}
+
+func processBanksBig(ctx context.Context, input io.Reader, parser func(string) (*big.Int, error), workers int) (*big.Int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	total, err := workpool.MapReduceStream(ctx, workpool.NumberedLines(input), workers,
+		func(_ context.Context, _ int, bank workpool.Line) (*big.Int, error) {
+			result, err := parser(bank.Text)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing bank on line %d %q: %w", bank.Number, bank.Text, err)
+			}
+			return result, nil
+		}, bigsum.Add)
+	if err != nil {
+		return nil, err
+	}
+	return bigsum.Add(total, nil), nil
+}
+
+func Part1(ctx context.Context, input io.Reader) (int, error) {
+	return processBanksWithWorkers(ctx, input,
+		BankQuery{Digits: part1Length}.Joltage, workpool.DefaultWorkers)
+}
+
+func Part2(ctx context.Context, input io.Reader) (int, error) {
+	return processBanksWithWorkers(ctx, input,
+		BankQuery{Digits: part2Length}.Joltage, workpool.DefaultWorkers)
+}
+
+// Solver picks Digits digits of every bank for both parts when set, instead
+// of 2 for part 1 and 12 for part 2. Beyond 18 digits the joltages no longer
+// fit in an int and aoc run reports their total from BigPart. Objective,
+// NoAdjacent and IncludeLast make up the BankQuery of every bank. With
+// Explain set, every bank is reported with its selected digits highlighted.
+type Solver struct {
+	Digits       int
+	Objective    Objective
+	NoAdjacent   bool
+	IncludeLast  bool
+	Explain      bool
+	ExplainStyle string
+	Workers      int
+
+	report io.Writer
+}
+
+func NewSolver() *Solver {
+	return &Solver{ExplainStyle: ExplainBrackets, report: io.Discard}
+}
+
+func (s *Solver) SetReport(w io.Writer) {
+	s.report = w
+}
+
+func (s *Solver) RegisterFlags(fs *flag.FlagSet) {
+	fs.IntVar(&s.Digits, "digits", s.Digits, "digits to pick from every bank for both parts, 0 for the puzzle's")
+	fs.Func("objective", "pick the digits forming the largest or smallest number: "+string(Maximize)+"|"+string(Minimize), func(v string) error {
+		if Objective(v) != Maximize && Objective(v) != Minimize {
+			return fmt.Errorf("expected %s|%s", Maximize, Minimize)
+		}
+		s.Objective = Objective(v)
+		return nil
+	})
+	fs.BoolVar(&s.NoAdjacent, "no-adjacent", s.NoAdjacent, "never pick two neighbouring digits")
+	fs.BoolVar(&s.IncludeLast, "include-last", s.IncludeLast, "always pick the last digit of every bank")
+	fs.BoolVar(&s.Explain, "explain", s.Explain, "report every bank with its picked digits highlighted and its joltage")
+	fs.StringVar(&s.ExplainStyle, "explain-style", s.ExplainStyle, "explain highlighting: "+ExplainBrackets+"|"+ExplainANSI)
+}
+
+func (s *Solver) query(part int) BankQuery {
+	q := BankQuery{Digits: s.Digits, Objective: s.Objective, NoAdjacent: s.NoAdjacent, IncludeLast: s.IncludeLast}
+	switch {
+	case q.Digits != 0:
+	case part == 1:
+		q.Digits = part1Length
+	default:
+		q.Digits = part2Length
+	}
+	return q
+}
+
+// UseBig reports whether the joltages do not fit in an int, or are explained,
+// which sums them with arbitrary precision anyway. Totals of smaller joltages
+// overflowing an int are left to aoc run to solve again with BigPart.
+func (s *Solver) UseBig() bool {
+	return s.Digits > maxJoltageDigits || s.Explain
+}
+
+func (s *Solver) BigPart(ctx context.Context, part int, input io.Reader) (*big.Int, error) {
+	if part != 1 && part != 2 {
+		return nil, fmt.Errorf("invalid part %d", part)
+	}
+	if s.Explain {
+		return explainBanks(ctx, input, part, s.query(part), s.ExplainStyle, s.report, s.Workers)
+	}
+	return processBanksBig(ctx, input, s.query(part).BigJoltage, s.Workers)
+}
+
+func (s *Solver) solve(ctx context.Context, part int, input io.Reader) (int, error) {
+	if !s.UseBig() {
+		return processBanksWithWorkers(ctx, input, s.query(part).Joltage, s.Workers)
+	}
+	total, err := s.BigPart(ctx, part, input)
+	if err != nil {
+		return 0, err
+	}
+	result, err := bigsum.Int(total)
+	if err != nil {
+		return 0, fmt.Errorf("total joltage %w", err)
+	}
+	return result, nil
+}
+
+func (s *Solver) SetWorkers(n int) {
+	s.Workers = n
+}
+
+func (s *Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return s.solve(ctx, 1, input)
+}
+
+func (s *Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return s.solve(ctx, 2, input)
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 3, NewSolver: func() aoc.Solver { return NewSolver() }})
+}
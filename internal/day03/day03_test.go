@@ -0,0 +1,602 @@
+package day03
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+func TestMaximumJoltagePart1(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+		indices  []int
+	}{
+		{
+			"987654321111111",
+			98,
+			[]int{0, 1},
+		}, {
+			"811111111111119",
+			89,
+			[]int{0, 14},
+		},
+		{
+			"234234234234278",
+			78,
+			[]int{13, 14},
+		},
+		{
+			"818181911112111",
+			92,
+			[]int{6, 11},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.input,
+			func(t *testing.T) {
+				result, indices, err := ParseBankPart1(tt.input)
+				if err != nil {
+					t.Fatalf("ParseBank() error = %v", err)
+				}
+				if result != tt.expected {
+					t.Errorf("ParseBank() = %v, want %v", result, tt.expected)
+				}
+				if !slices.Equal(indices, tt.indices) {
+					t.Errorf("ParseBank() indices = %v, want %v", indices, tt.indices)
+				}
+			},
+		)
+	}
+}
+
+func TestMaximumJoltagePart2(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+		indices  []int
+	}{
+		{
+			"987654321111111",
+			987654321111,
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		}, {
+			"811111111111119",
+			811111111119,
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 14},
+		},
+		{
+			"234234234234278",
+			434234234278,
+			[]int{2, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+		},
+		{
+			"818181911112111",
+			888911112111,
+			[]int{0, 2, 4, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.input,
+			func(t *testing.T) {
+				result, indices, err := ParseBankPart2(tt.input)
+				if err != nil {
+					t.Fatalf("ParseBankPart2() error = %v", err)
+				}
+				if result != tt.expected {
+					t.Errorf("ParseBankPart2() = %v, want %v", result, tt.expected)
+				}
+				if !slices.Equal(indices, tt.indices) {
+					t.Errorf("ParseBankPart2() indices = %v, want %v", indices, tt.indices)
+				}
+			},
+		)
+	}
+}
+
+func TestMaxSubsequence(t *testing.T) {
+	// maxSubsequenceGreedy picks every digit as the first largest one leaving
+	// room for the rest, in O(n·k).
+	maxSubsequenceGreedy := func(bank string, k int) string {
+		picked := make([]byte, 0, k)
+		start := 0
+		for i := range k {
+			best := start
+			for j := start; j < len(bank)-(k-i-1); j++ {
+				if bank[j] > bank[best] {
+					best = j
+				}
+			}
+			picked = append(picked, bank[best])
+			start = best + 1
+		}
+		return string(picked)
+	}
+	r := rand.New(rand.NewSource(1))
+	for range 1_000 {
+		bank := make([]byte, 1+r.Intn(40))
+		for i := range bank {
+			bank[i] = byte('1' + r.Intn(1+r.Intn(9)))
+		}
+		k := 1 + r.Intn(len(bank))
+		got, err := MaxSubsequence(string(bank), k)
+		if err != nil {
+			t.Fatalf("MaxSubsequence(%s, %d) error = %v", bank, k, err)
+		}
+		if expected := maxSubsequenceGreedy(string(bank), k); got != expected {
+			t.Errorf("MaxSubsequence(%s, %d) = %v, want %v", bank, k, got, expected)
+		}
+	}
+
+	tests := []struct {
+		bank string
+		k    int
+	}{
+		{"12", 3},
+		{"12", 0},
+		{"1x2", 1},
+	}
+	for _, tt := range tests {
+		if _, err := MaxSubsequence(tt.bank, tt.k); err == nil {
+			t.Errorf("MaxSubsequence(%s, %d) expected error", tt.bank, tt.k)
+		}
+	}
+	if _, err := Joltage("12345678901234567890", 19); err == nil {
+		t.Errorf("Joltage() expected overflow error for 19 digits")
+	}
+}
+
+func TestSolverDigits(t *testing.T) {
+	input := "987654321111111\n811111111111119\n234234234234278\n818181911112111\n"
+	tests := []struct {
+		digits    int
+		expected1 int
+		expected2 int
+	}{
+		{0, 357, 3121910778619},
+		{1, 9 + 9 + 8 + 9, 9 + 9 + 8 + 9},
+		{3, 987 + 819 + 478 + 921, 987 + 819 + 478 + 921},
+	}
+	for _, tt := range tests {
+		solver := Solver{Digits: tt.digits}
+		result1, err := solver.Part1(t.Context(), strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("Solver.Part1() error = %v", err)
+		}
+		result2, err := solver.Part2(t.Context(), strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("Solver.Part2() error = %v", err)
+		}
+		if result1 != tt.expected1 || result2 != tt.expected2 {
+			t.Errorf("Solver{Digits: %d} = %v, %v, want %v, %v", tt.digits, result1, result2, tt.expected1, tt.expected2)
+		}
+	}
+}
+
+func TestBankQuery(t *testing.T) {
+	// bruteForce tries every selection of q.Digits indices of bank.
+	bruteForce := func(q BankQuery, bank string) (string, bool) {
+		best, found := "", false
+		for mask := range 1 << len(bank) {
+			if bits.OnesCount(uint(mask)) != q.Digits ||
+				(q.NoAdjacent && mask&(mask>>1) != 0) ||
+				(q.IncludeLast && mask&(1<<(len(bank)-1)) == 0) {
+				continue
+			}
+			selected := make([]byte, 0, q.Digits)
+			for i := range len(bank) {
+				if mask&(1<<i) != 0 {
+					selected = append(selected, bank[i])
+				}
+			}
+			if !found || q.Objective.betterString(string(selected), best) {
+				best, found = string(selected), true
+			}
+		}
+		return best, found
+	}
+	r := rand.New(rand.NewSource(1))
+	for range 2_000 {
+		bank := make([]byte, 1+r.Intn(12))
+		for i := range bank {
+			bank[i] = byte('0' + r.Intn(1+r.Intn(10)))
+		}
+		q := BankQuery{
+			Digits:      1 + r.Intn(len(bank)),
+			Objective:   []Objective{"", Maximize, Minimize}[r.Intn(3)],
+			NoAdjacent:  r.Intn(2) == 0,
+			IncludeLast: r.Intn(2) == 0,
+		}
+		got, err := q.Select(string(bank))
+		expected, found := bruteForce(q, string(bank))
+		if !found {
+			if !errors.Is(err, errNoSelection) {
+				t.Errorf("%+v.Select(%s) = %v, %v, want errNoSelection", q, bank, got, err)
+			}
+			continue
+		}
+		if err != nil || got != expected {
+			t.Errorf("%+v.Select(%s) = %v, %v, want %v", q, bank, got, err, expected)
+		}
+		indices, _ := q.Indices(string(bank))
+		for i := 1; i < len(indices); i++ {
+			if gap := indices[i] - indices[i-1]; gap < 1 || (q.NoAdjacent && gap < 2) {
+				t.Errorf("%+v.Indices(%s) = %v, invalid gap", q, bank, indices)
+			}
+		}
+		if q.IncludeLast && indices[len(indices)-1] != len(bank)-1 {
+			t.Errorf("%+v.Indices(%s) = %v, last digit missing", q, bank, indices)
+		}
+	}
+
+	tests := []struct {
+		query    BankQuery
+		bank     string
+		expected string
+	}{
+		{BankQuery{Digits: 2}, "191", "91"},
+		{BankQuery{Digits: 2, NoAdjacent: true}, "191", "11"},
+		{BankQuery{Digits: 3, Objective: Minimize}, "818181911112111", "111"},
+		{BankQuery{Digits: 2, IncludeLast: true}, "987654321111111", "91"},
+		{BankQuery{Digits: 3, Objective: Minimize, IncludeLast: true, NoAdjacent: true}, "234234234234278", "228"},
+	}
+	for _, tt := range tests {
+		if got, err := tt.query.Select(tt.bank); err != nil || got != tt.expected {
+			t.Errorf("%+v.Select(%s) = %v, %v, want %v", tt.query, tt.bank, got, err, tt.expected)
+		}
+	}
+	if _, err := (BankQuery{Digits: 2, Objective: "median"}).Select("12"); err == nil {
+		t.Errorf("Select() expected error for objective median")
+	}
+}
+
+func TestRenderSelection(t *testing.T) {
+	tests := []struct {
+		style         string
+		expected      string
+		errorExpected bool
+	}{
+		{ExplainBrackets, "8181819[1]1112[1]1[1]", false},
+		{ExplainANSI, "8181819\x1b[1;32m1\x1b[0m1112\x1b[1;32m1\x1b[0m1\x1b[1;32m1\x1b[0m", false},
+		{"bold", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			result, err := RenderSelection("818181911112111", []int{7, 12, 14}, tt.style)
+			if (err != nil) != tt.errorExpected {
+				t.Fatalf("RenderSelection() error = %v, errorExpected %v", err, tt.errorExpected)
+			}
+			if result != tt.expected {
+				t.Errorf("RenderSelection() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSolverExplain(t *testing.T) {
+	solver := NewSolver()
+	solver.Explain = true
+	report := strings.Builder{}
+	solver.SetReport(&report)
+	result, err := solver.Part1(t.Context(), strings.NewReader("987654321111111\n811111111111119\n"))
+	if err != nil {
+		t.Fatalf("Solver.Part1() error = %v", err)
+	}
+	if result != 98+89 {
+		t.Errorf("Solver.Part1() = %v, want %v", result, 98+89)
+	}
+	expected := "part 1 line 1: [9][8]7654321111111 = 98\npart 1 line 2: [8]1111111111111[9] = 89\n"
+	if report.String() != expected {
+		t.Errorf("Solver.Part1() report = %q, want %q", report.String(), expected)
+	}
+}
+
+func TestSolverLineNumbers(t *testing.T) {
+	solver := NewSolver()
+	solver.Explain = true
+	report := strings.Builder{}
+	solver.SetReport(&report)
+	if _, err := solver.Part1(t.Context(), strings.NewReader("123\n\n456\n")); err != nil {
+		t.Fatalf("Solver.Part1() error = %v", err)
+	}
+	expected := "part 1 line 1: 1[2][3] = 23\npart 1 line 3: 4[5][6] = 56\n"
+	if report.String() != expected {
+		t.Errorf("Solver.Part1() report = %q, want %q", report.String(), expected)
+	}
+
+	explaining := NewSolver()
+	explaining.Explain = true
+	input := strings.Repeat("1", 25) + "\n\n" + strings.Repeat("1", 12) + "x" + strings.Repeat("1", 12) + "\n"
+	for _, solver := range []*Solver{NewSolver(), {Digits: 20}, explaining} {
+		_, err := solver.Part1(t.Context(), strings.NewReader(input))
+		if err == nil || !strings.Contains(err.Error(), "line 3") {
+			t.Errorf("Solver.Part1() error = %v, want it on line 3", err)
+		}
+	}
+}
+
+func TestSolverExplainOrder(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	banks := make([]string, 200)
+	expected := strings.Builder{}
+	total := 0
+	for i := range banks {
+		bank := make([]byte, 15)
+		for j := range bank {
+			bank[j] = byte('1' + r.Intn(9))
+		}
+		banks[i] = string(bank)
+		joltage, indices, err := ParseBankPart1(banks[i])
+		if err != nil {
+			t.Fatalf("ParseBankPart1() error = %v", err)
+		}
+		rendered, err := RenderSelection(banks[i], indices, ExplainBrackets)
+		if err != nil {
+			t.Fatalf("RenderSelection() error = %v", err)
+		}
+		fmt.Fprintf(&expected, "part 1 line %d: %s = %d\n", i+1, rendered, joltage)
+		total += joltage
+	}
+	for _, workers := range []int{1, 8} {
+		solver := NewSolver()
+		solver.Explain = true
+		solver.SetWorkers(workers)
+		report := strings.Builder{}
+		solver.SetReport(&report)
+		result, err := solver.Part1(t.Context(), strings.NewReader(strings.Join(banks, "\n")))
+		if err != nil {
+			t.Fatalf("Solver.Part1() error = %v", err)
+		}
+		if result != total {
+			t.Errorf("Solver.Part1(workers %d) = %v, want %v", workers, result, total)
+		}
+		if report.String() != expected.String() {
+			t.Errorf("Solver.Part1(workers %d) report out of order", workers)
+		}
+	}
+}
+
+func TestSolverBig(t *testing.T) {
+	input := "9876543210987654321012345\n1111111111111111111111119\n"
+	solver := Solver{Digits: 20}
+	if !solver.UseBig() {
+		t.Fatalf("Solver.UseBig() = false for 20 digits")
+	}
+	total, err := solver.BigPart(t.Context(), 1, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Solver.BigPart() error = %v", err)
+	}
+	expected, _ := new(big.Int).SetString("98765987654321012345", 10)
+	second, _ := new(big.Int).SetString("11111111111111111119", 10)
+	expected.Add(expected, second)
+	if total.Cmp(expected) != 0 {
+		t.Errorf("Solver.BigPart() = %v, want %v", total, expected)
+	}
+	if _, err := solver.Part1(t.Context(), strings.NewReader(input)); err == nil {
+		t.Errorf("Solver.Part1() expected overflow error for a total of %v", total)
+	}
+
+	nines := strings.Repeat(strings.Repeat("9", 19)+"\n", 10)
+	solver = Solver{Digits: 18}
+	if _, err := solver.Part1(t.Context(), strings.NewReader(nines)); !errors.Is(err, bigsum.ErrOverflow) {
+		t.Errorf("Solver.Part1() error = %v, want overflow summing 18 digit joltages", err)
+	}
+	total, err = solver.BigPart(t.Context(), 1, strings.NewReader(nines))
+	if err != nil {
+		t.Fatalf("Solver.BigPart() error = %v", err)
+	}
+	expected.SetString(strings.Repeat("9", 18), 10)
+	expected.Mul(expected, big.NewInt(10))
+	if total.Cmp(expected) != 0 {
+		t.Errorf("Solver.BigPart() = %v, want %v", total, expected)
+	}
+	if result, err := (&Solver{Digits: 18}).Part1(t.Context(), strings.NewReader(nines[:40])); err != nil || result != 2*999_999_999_999_999_999 {
+		t.Errorf("Solver.Part1() = %v, %v, want %v", result, err, 2*999_999_999_999_999_999)
+	}
+}
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{
+			"Provided example",
+			`987654321111111
+811111111111119
+234234234234278
+818181911112111
+`,
+			357,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Part1(t.Context(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Part1() error = %v", err)
+			}
+			if r != tt.expected {
+				t.Errorf("Part1() = %v, want %v", r, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{
+			"Provided example",
+			`987654321111111
+811111111111119
+234234234234278
+818181911112111
+`,
+			3121910778619,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Part2(t.Context(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Part2() error = %v", err)
+			}
+			if r != tt.expected {
+				t.Errorf("Part2() = %v, want %v", r, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProcessBanksErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		parser   func(string) (int, error)
+		expected string
+	}{
+		{
+			"Invalid digit part 1",
+			`987654321111111
+81111x111111119
+234234234234278
+`,
+			BankQuery{Digits: part1Length}.Joltage,
+			"error parsing bank on line 2",
+		},
+		{
+			"Short bank part 2",
+			`987654321111111
+811111111111119
+2342
+`,
+			BankQuery{Digits: part2Length}.Joltage,
+			"error parsing bank on line 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := processBanksWithWorkers(t.Context(), strings.NewReader(tt.input), tt.parser, workpool.DefaultWorkers)
+			if err == nil {
+				t.Fatalf("processBanksWithWorkers() expected error containing %q", tt.expected)
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("processBanksWithWorkers() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 3)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	input := string(data)
+	expected := 17359
+
+	b.Run("Part1", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := Part1(b.Context(), strings.NewReader(input))
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	input := string(data)
+	expected := 172787336861064
+
+	b.Run("Part2", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := Part2(b.Context(), strings.NewReader(input))
+			if err != nil {
+				b.Fatalf("benchmark failed: %v", err)
+			}
+			if result != expected {
+				b.Fatalf("expected %d, got %d", expected, result)
+			}
+		}
+	})
+}
+
+func BenchmarkParseBankPart1(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+
+	b.Run("ParseBankPart1", func(b *testing.B) {
+		b.ReportAllocs()
+		idx := 0
+		for b.Loop() {
+			line := lines[idx%len(lines)]
+			result, _, err := ParseBankPart1(line)
+			if err != nil {
+				b.Fatalf("ParseBankPart1 failed: %v", err)
+			}
+			_ = result
+			idx++
+		}
+	})
+}
+
+func BenchmarkParseBankPart2(b *testing.B) {
+	data, err := os.ReadFile(getInputPath())
+	if err != nil {
+		b.Fatalf("failed to read input file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+
+	b.Run("ParseBankPart2", func(b *testing.B) {
+		b.ReportAllocs()
+		idx := 0
+		for b.Loop() {
+			line := lines[idx%len(lines)]
+			result, _, err := ParseBankPart2(line)
+			if err != nil {
+				b.Fatalf("ParseBankPart2 failed: %v", err)
+			}
+			_ = result
+			idx++
+		}
+	})
+}
+
+func getInputPath() string {
+	return filepath.Join("..", "..", "cmd", "3", "input.txt")
+}
@@ -0,0 +1,95 @@
+package day03
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+const (
+	ExplainBrackets = "brackets"
+	ExplainANSI     = "ansi"
+)
+
+const (
+	ansiHighlight = "\x1b[1;32m"
+	ansiReset     = "\x1b[0m"
+)
+
+// RenderSelection returns bank with the digits at indices, which must be in
+// order, highlighted in style.
+func RenderSelection(bank string, indices []int, style string) (string, error) {
+	before, after := "[", "]"
+	switch style {
+	case ExplainBrackets:
+	case ExplainANSI:
+		before, after = ansiHighlight, ansiReset
+	default:
+		return "", fmt.Errorf("invalid explain style %q, expected %s|%s", style, ExplainBrackets, ExplainANSI)
+	}
+	sb := strings.Builder{}
+	sb.Grow(len(bank) + len(indices)*(len(before)+len(after)))
+	next := 0
+	for i := range len(bank) {
+		if next < len(indices) && indices[next] == i {
+			sb.WriteString(before)
+			sb.WriteByte(bank[i])
+			sb.WriteString(after)
+			next++
+			continue
+		}
+		sb.WriteByte(bank[i])
+	}
+	return sb.String(), nil
+}
+
+// explainBanks sums the joltages the query selects from the banks of input,
+// writing every bank with its selected digits highlighted in style along with
+// its joltage. Banks are explained by the workers as they are read, the lines
+// finished ahead of their turn being held back so they are written in order.
+func explainBanks(ctx context.Context, input io.Reader, part int, q BankQuery, style string, w io.Writer, workers int) (*big.Int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	var mu sync.Mutex
+	pending := make(map[int]string)
+	next := 0
+	total, err := workpool.MapReduceStream(ctx, workpool.NumberedLines(input), workers,
+		func(_ context.Context, i int, line workpool.Line) (*big.Int, error) {
+			bank := line.Text
+			indices, err := q.Indices(bank)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing bank on line %d %q: %w", line.Number, bank, err)
+			}
+			digits := make([]byte, len(indices))
+			for j, idx := range indices {
+				digits[j] = bank[idx]
+			}
+			joltage, _ := new(big.Int).SetString(string(digits), 10)
+			rendered, err := RenderSelection(bank, indices, style)
+			if err != nil {
+				return nil, err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			pending[i] = fmt.Sprintf("part %d line %d: %s = %s\n", part, line.Number, rendered, digits)
+			for ready, ok := pending[next]; ok; ready, ok = pending[next] {
+				if _, err := io.WriteString(w, ready); err != nil {
+					return nil, err
+				}
+				delete(pending, next)
+				next++
+			}
+			return joltage, nil
+		}, bigsum.Add)
+	if err != nil {
+		return nil, err
+	}
+	return bigsum.Add(total, nil), nil
+}
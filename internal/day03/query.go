@@ -0,0 +1,163 @@
+package day03
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/sontanon/aoc-2025/internal/bigsum"
+)
+
+type Objective string
+
+const (
+	Maximize Objective = "max"
+	Minimize Objective = "min"
+)
+
+// betterString reports whether digits a beat digits b of the same length, the
+// zero Objective maximizing.
+func (o Objective) betterString(a, b string) bool {
+	if o == Minimize {
+		return a < b
+	}
+	return a > b
+}
+
+// BankQuery selects Digits digits of a bank, kept in order, forming the best
+// number for Objective. NoAdjacent forbids selecting neighbouring digits and
+// IncludeLast requires selecting the last digit of the bank.
+type BankQuery struct {
+	Digits      int
+	Objective   Objective
+	NoAdjacent  bool
+	IncludeLast bool
+}
+
+var errNoSelection = errors.New("no selection satisfies the query")
+
+// Indices returns the indices of the selected digits of bank, in order.
+// Without NoAdjacent the monotonic stack of appendSubsequence picks them
+// greedily, the last digit being appended to the selection of the others for
+// IncludeLast. Skipping neighbours breaks the greedy choice, the best first
+// digit possibly leaving no room for the others, so NoAdjacent falls back to
+// dynamic programming.
+func (q BankQuery) Indices(bank string) ([]int, error) {
+	return q.appendIndices(make([]int, 0, q.Digits), bank)
+}
+
+// Select returns the selected digits of bank.
+func (q BankQuery) Select(bank string) (string, error) {
+	indices, err := q.Indices(bank)
+	if err != nil {
+		return "", err
+	}
+	digits := make([]byte, len(indices))
+	for i, idx := range indices {
+		digits[i] = bank[idx]
+	}
+	return string(digits), nil
+}
+
+// Joltage returns the selected digits of bank as an int.
+func (q BankQuery) Joltage(bank string) (int, error) {
+	if q.Digits > maxJoltageDigits {
+		return 0, fmt.Errorf("joltage of %d digits %w", q.Digits, bigsum.ErrOverflow)
+	}
+	var buffer [maxJoltageDigits]int
+	indices, err := q.appendIndices(buffer[:0], bank)
+	if err != nil {
+		return 0, err
+	}
+	return joltageOf(bank, indices), nil
+}
+
+// BigJoltage returns the selected digits of bank as an arbitrary precision
+// integer, so that Digits may exceed the 18 an int always holds.
+func (q BankQuery) BigJoltage(bank string) (*big.Int, error) {
+	digits, err := q.Select(bank)
+	if err != nil {
+		return nil, err
+	}
+	result, _ := new(big.Int).SetString(digits, 10)
+	return result, nil
+}
+
+func (q BankQuery) appendIndices(dst []int, bank string) ([]int, error) {
+	if q.Objective != "" && q.Objective != Maximize && q.Objective != Minimize {
+		return nil, fmt.Errorf("invalid objective %q, expected %s|%s", q.Objective, Maximize, Minimize)
+	}
+	if q.Digits <= 0 {
+		return nil, fmt.Errorf("invalid digit count %d", q.Digits)
+	}
+	if len(bank) < q.Digits {
+		return nil, fmt.Errorf("bank of %d digits is too short to pick %d", len(bank), q.Digits)
+	}
+	switch {
+	case q.NoAdjacent:
+		for i := range len(bank) {
+			if !validByte(bank[i]) {
+				return nil, fmt.Errorf("invalid digit at index %d: %q", i, bank[i])
+			}
+		}
+		return q.appendDP(dst, bank)
+	case q.IncludeLast:
+		last := len(bank) - 1
+		if !validByte(bank[last]) {
+			return nil, fmt.Errorf("invalid digit at index %d: %q", last, bank[last])
+		}
+		dst, err := appendSubsequence(dst, bank[:last], q.Digits-1, q.Objective)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, last), nil
+	default:
+		return appendSubsequence(dst, bank, q.Digits, q.Objective)
+	}
+}
+
+// appendDP appends the indices of the selection of bank to dst, best[i][j]
+// being the best j digits of bank[i:] satisfying the query when ok[i][j] is
+// set. Those either skip bank[i] or select it, as recorded by selected[i][j],
+// followed by the best j-1 digits from the next selectable one.
+func (q BankQuery) appendDP(dst []int, bank string) ([]int, error) {
+	n, k := len(bank), q.Digits
+	step := 1
+	if q.NoAdjacent {
+		step = 2
+	}
+	best := make([][]string, n+step)
+	ok := make([][]bool, n+step)
+	selected := make([][]bool, n+step)
+	for i := range best {
+		best[i] = make([]string, k+1)
+		ok[i] = make([]bool, k+1)
+		selected[i] = make([]bool, k+1)
+		ok[i][0] = true
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := 1; j <= k; j++ {
+			if ok[i+1][j] {
+				best[i][j], ok[i][j] = best[i+1][j], true
+			}
+			if !ok[i+step][j-1] || (j == 1 && q.IncludeLast && i != n-1) {
+				continue
+			}
+			if digits := bank[i:i+1] + best[i+step][j-1]; !ok[i][j] || q.Objective.betterString(digits, best[i][j]) {
+				best[i][j], ok[i][j], selected[i][j] = digits, true, true
+			}
+		}
+	}
+	if !ok[0][k] {
+		return nil, fmt.Errorf("selecting %d digits of a bank of %d: %w", k, n, errNoSelection)
+	}
+	for i, j := 0, k; j > 0; {
+		if selected[i][j] {
+			dst = append(dst, i)
+			i, j = i+step, j-1
+		} else {
+			i++
+		}
+	}
+	return dst, nil
+}
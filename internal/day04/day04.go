@@ -0,0 +1,226 @@
+package day04
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+const (
+	maxSafetyIterations = 1_000_000
+	maxNeighbors        = 4
+)
+
+// Matrix is the grid of rolls, one byte per cell holding 1 for a roll, padded
+// with a border of empty cells.
+type Matrix struct {
+	data [][]byte
+	rows int
+	cols int
+}
+
+func (m Matrix) CalculateMask(ctx context.Context, mask [][]bool, workers int) (int, error) {
+	return workpool.MapReduceChunks(ctx, m.rows-2, workers, func(_ context.Context, start, end int) (int, error) {
+		canBeRemoved := 0
+		for row := start + 1; row < end+1; row++ {
+			for col := 1; col < m.cols-1; col++ {
+				removable := ParseElement(m.data, row, col)
+				mask[row][col] = removable == 1
+				canBeRemoved += removable
+			}
+		}
+		return canBeRemoved, nil
+	}, workpool.Sum)
+}
+
+func (m *Matrix) ApplyMask(ctx context.Context, mask [][]bool, workers int) error {
+	return workpool.ForEachChunk(ctx, m.rows-2, workers, func(_ context.Context, start, end int) error {
+		for row := start + 1; row < end+1; row++ {
+			for col := 1; col < m.cols-1; col++ {
+				if mask[row][col] {
+					m.data[row][col] = 0
+					mask[row][col] = false
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (m *Matrix) RemoveRolls(ctx context.Context, workers, maxIterations int) (int, error) {
+	if maxIterations < 1 {
+		return 0, nil
+	}
+
+	mask := make([][]bool, m.rows)
+	for i := range mask {
+		mask[i] = make([]bool, m.cols)
+	}
+
+	removed := 0
+	for range maxIterations {
+		removedThisRound, err := m.CalculateMask(ctx, mask, workers)
+		if err != nil {
+			return 0, err
+		}
+		if removedThisRound == 0 {
+			break
+		}
+		removed += removedThisRound
+		if err := m.ApplyMask(ctx, mask, workers); err != nil {
+			return 0, err
+		}
+	}
+	return removed, nil
+}
+
+var errEmptyInput = errors.New("input is empty")
+
+func ParseInput(input io.Reader) (Matrix, error) {
+	var matrix [][]byte
+	cols := 0
+	for line, err := range workpool.Tokens(input, '\n') {
+		if err != nil {
+			return Matrix{}, fmt.Errorf("error reading input: %w", err)
+		}
+		if matrix == nil {
+			cols = len(line)
+			matrix = [][]byte{make([]byte, cols+2)}
+		}
+		row, err := parseRow(line, cols)
+		if err != nil {
+			return Matrix{}, err
+		}
+		matrix = append(matrix, row)
+	}
+	if matrix == nil {
+		return Matrix{}, errEmptyInput
+	}
+	matrix = append(matrix, make([]byte, cols+2))
+	return Matrix{data: matrix, rows: len(matrix), cols: cols + 2}, nil
+}
+
+// parseRow returns line as a padded row of cols cells.
+func parseRow(line string, cols int) ([]byte, error) {
+	if len(line) != cols {
+		return nil, fmt.Errorf("inconsistent row lengths: expected %d, got %d", cols, len(line))
+	}
+	row := make([]byte, cols+2)
+	for j, char := range line {
+		switch char {
+		case '@':
+			row[j+1] = 1
+		case '.':
+			// row[j+1] = 0
+		default:
+			return nil, fmt.Errorf("invalid character '%c' in input", char)
+		}
+	}
+	return row, nil
+}
+
+// rowWindows yields every row of input between the rows above and below it,
+// so that the rolls accessible at first can be counted holding only a few
+// rows in memory.
+func rowWindows(input io.Reader) iter.Seq2[[3][]byte, error] {
+	return func(yield func([3][]byte, error) bool) {
+		var padding, above, current []byte
+		for line, err := range workpool.Tokens(input, '\n') {
+			if err != nil {
+				yield([3][]byte{}, fmt.Errorf("error reading input: %w", err))
+				return
+			}
+			if current == nil {
+				padding = make([]byte, len(line)+2)
+			}
+			row, err := parseRow(line, len(padding)-2)
+			if err != nil {
+				yield([3][]byte{}, err)
+				return
+			}
+			if current == nil {
+				above, current = padding, row
+				continue
+			}
+			if !yield([3][]byte{above, current, row}, nil) {
+				return
+			}
+			above, current = current, row
+		}
+		if current == nil {
+			yield([3][]byte{}, errEmptyInput)
+			return
+		}
+		yield([3][]byte{above, current, padding}, nil)
+	}
+}
+
+func countAccessible(ctx context.Context, input io.Reader, workers int) (int, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	return workpool.MapReduceStream(ctx, rowWindows(input), workers,
+		func(_ context.Context, _ int, window [3][]byte) (int, error) {
+			accessible := 0
+			for col := 1; col < len(window[1])-1; col++ {
+				accessible += ParseElement(window[:], 1, col)
+			}
+			return accessible, nil
+		}, workpool.Sum)
+}
+
+func ParseElement(m [][]byte, row, col int) int {
+	if m[row][col] == 0 {
+		return 0
+	}
+	above, current, below := m[row-1], m[row], m[row+1]
+	neighbors := int(above[col-1]) + int(above[col]) + int(above[col+1]) + int(current[col-1]) + int(current[col+1]) +
+		int(below[col-1]) + int(below[col]) + int(below[col+1])
+
+	if neighbors >= maxNeighbors {
+		return 0
+	}
+	return 1
+}
+
+func removeRolls(ctx context.Context, input io.Reader, workers, maxIterations int) (int, error) {
+	end := aoc.StartPhase(ctx, aoc.PhaseParse)
+	matrix, err := ParseInput(input)
+	end()
+	if err != nil {
+		return 0, fmt.Errorf("error parsing input: %w", err)
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	return matrix.RemoveRolls(ctx, workers, maxIterations)
+}
+
+func Part1(ctx context.Context, input io.Reader) (int, error) {
+	return countAccessible(ctx, input, workpool.DefaultWorkers)
+}
+
+func Part2(ctx context.Context, input io.Reader) (int, error) {
+	return removeRolls(ctx, input, workpool.DefaultWorkers, maxSafetyIterations)
+}
+
+type Solver struct {
+	Workers int
+}
+
+func (s *Solver) SetWorkers(n int) {
+	s.Workers = n
+}
+
+func (s *Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return countAccessible(ctx, input, s.Workers)
+}
+
+func (s *Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return removeRolls(ctx, input, s.Workers, maxSafetyIterations)
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 4, NewSolver: func() aoc.Solver { return &Solver{} }})
+}
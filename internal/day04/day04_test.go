@@ -1,9 +1,13 @@
-package main
+package day04
 
 import (
+	"math/rand"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
 )
 
 func TestParseInput(t *testing.T) {
@@ -20,7 +24,7 @@ func TestParseInput(t *testing.T) {
 @@@@@
 @.@@@
 @@.@@`,
-			Matrix{[][]int{
+			Matrix{[][]byte{
 				{0, 0, 0, 0, 0, 0, 0},
 				{0, 0, 0, 1, 1, 0, 0},
 				{0, 1, 1, 1, 0, 1, 0},
@@ -100,7 +104,7 @@ func TestAccessibleRollsPart1(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part1(
-					strings.NewReader(tt.input))
+					t.Context(), strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part1() error = %v", err)
 				}
@@ -111,6 +115,44 @@ func TestAccessibleRollsPart1(t *testing.T) {
 	}
 }
 
+func TestCountAccessible(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{1, 2, 3, 17} {
+		rows := make([]string, size)
+		for i := range rows {
+			row := make([]byte, size)
+			for j := range row {
+				row[j] = ".@"[r.Intn(2)]
+			}
+			rows[i] = string(row)
+		}
+		input := strings.Join(rows, "\n")
+		matrix, err := ParseInput(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("ParseInput() error = %v", err)
+		}
+		expected, err := matrix.RemoveRolls(t.Context(), 1, 1)
+		if err != nil {
+			t.Fatalf("RemoveRolls() error = %v", err)
+		}
+		for _, workers := range []int{1, 4} {
+			result, err := countAccessible(t.Context(), strings.NewReader(input), workers)
+			if err != nil {
+				t.Fatalf("countAccessible() error = %v", err)
+			}
+			if result != expected {
+				t.Errorf("countAccessible(size %d, workers %d) = %v, want %v", size, workers, result, expected)
+			}
+		}
+	}
+
+	for _, input := range []string{"", "..@\n.@", "..@\n.A@"} {
+		if _, err := countAccessible(t.Context(), strings.NewReader(input), 2); err == nil {
+			t.Errorf("countAccessible(%q) expected an error", input)
+		}
+	}
+}
+
 func TestAccessibleRollsPart2(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -137,7 +179,7 @@ func TestAccessibleRollsPart2(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part2(
-					strings.NewReader(tt.input))
+					t.Context(), strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
 				}
@@ -148,6 +190,10 @@ func TestAccessibleRollsPart2(t *testing.T) {
 	}
 }
 
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 4)
+}
+
 func BenchmarkPart1(b *testing.B) {
 	data, err := os.ReadFile(getInputPath())
 	if err != nil {
@@ -159,7 +205,7 @@ func BenchmarkPart1(b *testing.B) {
 	b.Run("ParseWithWorkersChannels", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part1(strings.NewReader(input))
+			result, err := Part1(b.Context(), strings.NewReader(input))
 			if err != nil {
 				b.Fatalf("benchmark failed: %v", err)
 			}
@@ -181,7 +227,7 @@ func BenchmarkPart2(b *testing.B) {
 	b.Run("ParseWithWorkersChannels", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part2(strings.NewReader(input))
+			result, err := Part2(b.Context(), strings.NewReader(input))
 			if err != nil {
 				b.Fatalf("benchmark failed: %v", err)
 			}
@@ -204,3 +250,7 @@ func equalMatrices(a, b Matrix) bool {
 	}
 	return true
 }
+
+func getInputPath() string {
+	return filepath.Join("..", "..", "cmd", "4", "input.txt")
+}
@@ -0,0 +1,251 @@
+package day05
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+type Range struct {
+	Start int
+	End   int
+}
+
+type SparseRange struct {
+	SubRanges     []Range
+	GlobalMinimum int
+	GlobalMaximum int
+}
+
+func (sr SparseRange) Normalize() SparseRange {
+	n := len(sr.SubRanges)
+	if n <= 1 {
+		return sr
+	}
+
+	newRanges := make([]Range, 0, n)
+	left := sr.SubRanges[0]
+	for _, right := range sr.SubRanges[1:] {
+		if right.Start <= left.End+1 {
+			left.End = max(left.End, right.End)
+		} else {
+			newRanges = append(newRanges, left)
+			left = right
+		}
+	}
+	newRanges = append(newRanges, left)
+	return SparseRange{newRanges, newRanges[0].Start, newRanges[len(newRanges)-1].End}
+}
+
+func (sr SparseRange) Contains(id int) bool {
+	if len(sr.SubRanges) == 0 || id > sr.GlobalMaximum || id < sr.GlobalMinimum {
+		return false
+	}
+	k, found := slices.BinarySearchFunc(sr.SubRanges, id, func(r Range, target int) int {
+		return cmp.Compare(r.Start, target)
+	})
+	if found {
+		return true
+	}
+	if k == 0 {
+		return false
+	}
+	return id <= sr.SubRanges[k-1].End
+}
+
+// ParseRanges reads the ranges section up to the blank line separating it from
+// the ids, leaving br positioned at the first id.
+func ParseRanges(br *bufio.Reader) (SparseRange, error) {
+	ranges := make([]Range, 0)
+	found := false
+	for line, err := range workpool.Lines(br) {
+		if err != nil {
+			return SparseRange{}, err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if len(ranges) == 0 {
+				continue
+			}
+			found = true
+			break
+		}
+		startStr, endStr, ok := strings.Cut(line, "-")
+		if !ok {
+			return SparseRange{}, errors.New("invalid range format")
+		}
+		start, err := strconv.Atoi(startStr)
+		if err != nil {
+			return SparseRange{}, err
+		}
+		end, err := strconv.Atoi(endStr)
+		if err != nil {
+			return SparseRange{}, err
+		}
+		if end < start {
+			return SparseRange{}, errors.New("range end less than start")
+		}
+		ranges = append(ranges, Range{Start: start, End: end})
+	}
+	if !found {
+		return SparseRange{}, errors.New("invalid input format")
+	}
+	slices.SortFunc(ranges, func(a, b Range) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	sr := SparseRange{
+		SubRanges:     ranges,
+		GlobalMinimum: ranges[0].Start,
+		GlobalMaximum: ranges[len(ranges)-1].End,
+	}
+	return sr.Normalize(), nil
+}
+
+func ParseInput(input io.Reader) (SparseRange, []int, error) {
+	br := bufio.NewReader(input)
+	sr, err := ParseRanges(br)
+	if err != nil {
+		return SparseRange{}, nil, err
+	}
+	ids := make([]int, 0)
+	for line, err := range workpool.Tokens(br, '\n') {
+		if err != nil {
+			return SparseRange{}, nil, err
+		}
+		id, err := strconv.Atoi(line)
+		if err != nil {
+			return SparseRange{}, nil, err
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return SparseRange{}, nil, errNoIds
+	}
+	return sr, ids, nil
+}
+
+var errNoIds = errors.New("no ids provided")
+
+// parseRanges times ParseRanges as the parse phase, the ids being parsed while
+// solving.
+func parseRanges(ctx context.Context, input io.Reader) (*bufio.Reader, SparseRange, error) {
+	defer aoc.StartPhase(ctx, aoc.PhaseParse)()
+	br := bufio.NewReader(input)
+	sr, err := ParseRanges(br)
+	return br, sr, err
+}
+
+func Part1Sequential(ctx context.Context, input io.Reader) (int, error) {
+	br, sr, err := parseRanges(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	count, ids := 0, 0
+	for line, err := range workpool.Tokens(br, '\n') {
+		if err != nil {
+			return 0, err
+		}
+		id, err := strconv.Atoi(line)
+		if err != nil {
+			return 0, err
+		}
+		ids++
+		if sr.Contains(id) {
+			count++
+		}
+	}
+	if ids == 0 {
+		return 0, errNoIds
+	}
+	return count, nil
+}
+
+// idCount is the number of ids read along with how many of them are fresh.
+type idCount struct {
+	ids   int
+	fresh int
+}
+
+func addIdCounts(a, b idCount) idCount {
+	return idCount{a.ids + b.ids, a.fresh + b.fresh}
+}
+
+// Part1Parallel feeds the ids to the workers line by line as they are read.
+func Part1Parallel(ctx context.Context, input io.Reader, workers int) (int, error) {
+	br, sr, err := parseRanges(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	count, err := workpool.MapReduceStream(ctx, workpool.Tokens(br, '\n'), workers, func(_ context.Context, i int, line string) (idCount, error) {
+		id, err := strconv.Atoi(line)
+		if err != nil {
+			return idCount{}, fmt.Errorf("invalid id at position %d: %w", i+1, err)
+		}
+		if sr.Contains(id) {
+			return idCount{1, 1}, nil
+		}
+		return idCount{1, 0}, nil
+	}, addIdCounts)
+	if err != nil {
+		return 0, err
+	}
+	if count.ids == 0 {
+		return 0, errNoIds
+	}
+	return count.fresh, nil
+}
+
+// Part2Sequential stops reading at the end of the ranges, the ids not being
+// needed.
+func Part2Sequential(ctx context.Context, input io.Reader) (int, error) {
+	_, sr, err := parseRanges(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	count := 0
+	for _, r := range sr.SubRanges {
+		count += r.End - r.Start + 1
+	}
+	return count, nil
+}
+
+func Part2Parallel(ctx context.Context, input io.Reader, workers int) (int, error) {
+	_, sr, err := parseRanges(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	return workpool.MapReduceChunks(ctx, len(sr.SubRanges), workers, func(_ context.Context, start, end int) (int, error) {
+		localCount := 0
+		for _, r := range sr.SubRanges[start:end] {
+			localCount += r.End - r.Start + 1
+		}
+		return localCount, nil
+	}, workpool.Sum)
+}
+
+type Solver struct{}
+
+func (Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return Part1Sequential(ctx, input)
+}
+
+func (Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return Part2Sequential(ctx, input)
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 5, NewSolver: func() aoc.Solver { return Solver{} }})
+}
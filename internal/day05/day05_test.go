@@ -1,10 +1,14 @@
-package main
+package day05
 
 import (
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
+	"github.com/sontanon/aoc-2025/internal/workpool"
 )
 
 func TestParseInput(t *testing.T) {
@@ -63,9 +67,10 @@ func TestParseInput(t *testing.T) {
 
 func TestPart1(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    string
-		expected int
+		name        string
+		input       string
+		expected    int
+		expectError bool
 	}{
 		{
 			"Provided example",
@@ -81,6 +86,13 @@ func TestPart1(t *testing.T) {
 17
 32`,
 			3,
+			false,
+		},
+		{
+			"No ids",
+			"3-5\n10-14\n\n",
+			0,
+			true,
 		},
 	}
 	for _, tt := range tests {
@@ -88,13 +100,22 @@ func TestPart1(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part1Sequential(
+					t.Context(),
 					strings.NewReader(tt.input))
-				if err != nil {
-					t.Fatalf("Part1() error = %v", err)
+				if (err != nil) != tt.expectError {
+					t.Fatalf("Part1() error = %v, expectError %v", err, tt.expectError)
 				}
 				if result != tt.expected {
 					t.Errorf("Part1() = %v, want %v", result, tt.expected)
 				}
+				result, err = Part1Parallel(
+					t.Context(), strings.NewReader(tt.input), 3)
+				if (err != nil) != tt.expectError {
+					t.Fatalf("Part1Parallel() error = %v, expectError %v", err, tt.expectError)
+				}
+				if result != tt.expected {
+					t.Errorf("Part1Parallel() = %v, want %v", result, tt.expected)
+				}
 			})
 	}
 }
@@ -126,6 +147,7 @@ func TestPart2(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part2Sequential(
+					t.Context(),
 					strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
@@ -133,10 +155,22 @@ func TestPart2(t *testing.T) {
 				if result != tt.expected {
 					t.Errorf("Part2() = %v, want %v", result, tt.expected)
 				}
+				result, err = Part2Parallel(
+					t.Context(), strings.NewReader(tt.input), 3)
+				if err != nil {
+					t.Fatalf("Part2Parallel() error = %v", err)
+				}
+				if result != tt.expected {
+					t.Errorf("Part2Parallel() = %v, want %v", result, tt.expected)
+				}
 			})
 	}
 }
 
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 5)
+}
+
 func BenchmarkPart1(b *testing.B) {
 	data, err := os.ReadFile(getInputPath())
 	if err != nil {
@@ -146,7 +180,7 @@ func BenchmarkPart1(b *testing.B) {
 	b.Run("Part1Parallel", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part1Parallel(strings.NewReader(string(data)), numWorkers)
+			result, err := Part1Parallel(b.Context(), strings.NewReader(string(data)), workpool.DefaultWorkers)
 			if err != nil {
 				b.Fatalf("Part1() error = %v", err)
 			}
@@ -158,7 +192,7 @@ func BenchmarkPart1(b *testing.B) {
 	b.Run("Part1Sequential", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part1Sequential(strings.NewReader(string(data)))
+			result, err := Part1Sequential(b.Context(), strings.NewReader(string(data)))
 			if err != nil {
 				b.Fatalf("Part1() error = %v", err)
 			}
@@ -178,7 +212,7 @@ func BenchmarkPart2(b *testing.B) {
 	b.Run("Part2Parallel", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part2Parallel(strings.NewReader(string(data)), numWorkers)
+			result, err := Part2Parallel(b.Context(), strings.NewReader(string(data)), workpool.DefaultWorkers)
 			if err != nil {
 				b.Fatalf("Part2() error = %v", err)
 			}
@@ -190,7 +224,7 @@ func BenchmarkPart2(b *testing.B) {
 	b.Run("Part2Sequential", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part2Sequential(strings.NewReader(string(data)))
+			result, err := Part2Sequential(b.Context(), strings.NewReader(string(data)))
 			if err != nil {
 				b.Fatalf("Part2() error = %v", err)
 			}
@@ -215,3 +249,7 @@ func equalInputs(a, b SparseRange) bool {
 	}
 	return true
 }
+
+func getInputPath() string {
+	return filepath.Join("..", "..", "cmd", "5", "input.txt")
+}
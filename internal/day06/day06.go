@@ -0,0 +1,263 @@
+package day06
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+	"github.com/sontanon/aoc-2025/internal/workpool"
+)
+
+type Operation string
+
+const (
+	OperationInvalid Operation = ""
+	OperationAdd     Operation = "+"
+	OperationMul     Operation = "*"
+)
+
+type Worksheet struct {
+	Columns    [][]int
+	Operations []Operation
+}
+
+func ParseInput(input io.Reader) (Worksheet, error) {
+	var previousLine string
+	hasContent := false
+	ws := Worksheet{}
+
+	for currentLine, err := range workpool.Lines(input) {
+		if err != nil {
+			return Worksheet{}, err
+		}
+		if hasContent {
+			if err := ws.addColumn(previousLine); err != nil {
+				return Worksheet{}, err
+			}
+		}
+		hasContent = true
+		previousLine = currentLine
+	}
+	if err := ws.addOperations(previousLine); err != nil {
+		return Worksheet{}, err
+	}
+	return ws, nil
+}
+
+func (ws *Worksheet) addColumn(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return errors.New("empty line found when parsing columns")
+	}
+
+	if ws.Columns == nil {
+		ws.Columns = make([][]int, len(fields))
+	}
+
+	if len(fields) != len(ws.Columns) {
+		return fmt.Errorf("line has %d columns, expected %d", len(fields), len(ws.Columns))
+	}
+
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return fmt.Errorf("invalid integer '%s' at column %d: %w", field, i, err)
+		}
+		ws.Columns[i] = append(ws.Columns[i], value)
+	}
+
+	return nil
+}
+
+func (ws *Worksheet) addOperations(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return errors.New("empty line found when parsing operations")
+	}
+	if len(fields) != len(ws.Columns) {
+		return fmt.Errorf("number of operations %d does not match number of columns %d", len(fields), len(ws.Columns))
+	}
+
+	tempBuffer := make([]Operation, 0)
+	for i, field := range fields {
+		operation := Operation(field)
+		if operation != OperationAdd && operation != OperationMul {
+			return fmt.Errorf("invalid operation '%s' at column %d", string(operation), i)
+		}
+		tempBuffer = append(tempBuffer, operation)
+	}
+	ws.Operations = tempBuffer
+	return nil
+}
+
+func (ws Worksheet) Calculate() int {
+	results := make([]int, len(ws.Columns))
+
+	for j := range ws.Columns {
+		switch ws.Operations[j] {
+		case OperationAdd:
+			results[j] = ApplyAddition(ws.Columns[j])
+		case OperationMul:
+			results[j] = ApplyMultiplication(ws.Columns[j])
+		default:
+			panic(fmt.Errorf("invalid operation '%s' at position %d", string(ws.Operations[j]), j))
+		}
+	}
+
+	result := 0
+	for j := range results {
+		result += results[j]
+	}
+	return result
+}
+
+func ApplyAddition(col []int) int {
+	result := 0
+	for j := range col {
+		result += col[j]
+	}
+	return result
+}
+
+func ApplyMultiplication(col []int) int {
+	result := 1
+	for j := range col {
+		result *= col[j]
+	}
+	return result
+}
+
+func Part1(ctx context.Context, input io.Reader) (int, error) {
+	end := aoc.StartPhase(ctx, aoc.PhaseParse)
+	ws, err := ParseInput(input)
+	end()
+	if err != nil {
+		return 0, err
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	return ws.Calculate(), nil
+}
+
+// Part2 reads the worksheet line by line, keeping only the number being built
+// in every character column, so the digits of a column are read top to bottom.
+func Part2(ctx context.Context, input io.Reader) (int, error) {
+	end := aoc.StartPhase(ctx, aoc.PhaseParse)
+	cv, ops, err := parseColumns(input)
+	end()
+	if err != nil {
+		return 0, err
+	}
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	return cv.calculate(ops)
+}
+
+func parseColumns(input io.Reader) (columnValues, []string, error) {
+	var cv columnValues
+	var previousLine string
+	numLines := 0
+	for line, err := range workpool.Lines(input) {
+		if err != nil {
+			return columnValues{}, nil, err
+		}
+		if line == "" {
+			continue
+		}
+		if numLines > 0 {
+			if err := cv.addLine(previousLine, numLines); err != nil {
+				return columnValues{}, nil, err
+			}
+		}
+		numLines++
+		previousLine = line
+	}
+	if numLines < 2 {
+		return columnValues{}, nil, fmt.Errorf("input must contain at least two lines, received %d", numLines)
+	}
+	ops := strings.Fields(previousLine)
+	for i, op := range ops {
+		if op != string(OperationAdd) && op != string(OperationMul) {
+			return columnValues{}, nil, fmt.Errorf("invalid operation at position %d: '%s'", i, op)
+		}
+	}
+	return cv, ops, nil
+}
+
+type columnValues struct {
+	values   []int
+	hasDigit []bool
+}
+
+func (cv *columnValues) addLine(line string, lineNumber int) error {
+	if cv.values == nil {
+		cv.values = make([]int, len(line))
+		cv.hasDigit = make([]bool, len(line))
+	}
+	if len(line) != len(cv.values) {
+		return fmt.Errorf("inconsistent line length for line %d, received length %d but expected %d", lineNumber, len(line), len(cv.values))
+	}
+	for x := range len(line) {
+		b := line[x]
+		switch {
+		case b == ' ':
+		case b >= '0' && b <= '9':
+			cv.values[x] = 10*cv.values[x] + int(b-'0')
+			cv.hasDigit[x] = true
+		default:
+			return fmt.Errorf("received unexpected byte at line %d, offset %d: %q", lineNumber, x, b)
+		}
+	}
+	return nil
+}
+
+// calculate applies ops to the problems, each a run of columns holding digits
+// delimited by columns of spaces.
+func (cv columnValues) calculate(ops []string) (int, error) {
+	result := 0
+	j := 0
+	for x := 0; x < len(cv.values); {
+		if !cv.hasDigit[x] {
+			x++
+			continue
+		}
+		if j >= len(ops) {
+			return 0, fmt.Errorf("found more problems than the %d operations", len(ops))
+		}
+		op := Operation(ops[j])
+		problemResult := 0
+		if op == OperationMul {
+			problemResult = 1
+		}
+		for ; x < len(cv.values) && cv.hasDigit[x]; x++ {
+			switch op {
+			case OperationAdd:
+				problemResult += cv.values[x]
+			case OperationMul:
+				problemResult *= cv.values[x]
+			}
+		}
+		result += problemResult
+		j++
+	}
+	if j != len(ops) {
+		return 0, fmt.Errorf("found %d problems but %d operations", j, len(ops))
+	}
+	return result, nil
+}
+
+type Solver struct{}
+
+func (Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return Part1(ctx, input)
+}
+
+func (Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return Part2(ctx, input)
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 6, NewSolver: func() aoc.Solver { return Solver{} }})
+}
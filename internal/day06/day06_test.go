@@ -1,9 +1,12 @@
-package main
+package day06
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
 )
 
 func TestParseInput(t *testing.T) {
@@ -74,6 +77,7 @@ func TestPart1(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part1(
+					t.Context(),
 					strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part1() error = %v", err)
@@ -106,6 +110,7 @@ func TestPart2(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part2(
+					t.Context(),
 					strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
@@ -117,6 +122,35 @@ func TestPart2(t *testing.T) {
 	}
 }
 
+func TestPart2Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Single line", "*   +\n", "at least two lines"},
+		{"Inconsistent lines", "12 3\n4 56 \n*  +\n", "inconsistent line length for line 2"},
+		{"Invalid byte", "12 3\n4x 5\n*  +\n", "unexpected byte at line 2, offset 1"},
+		{"Invalid operation", "12 3\n4  5\n*  -\n", "invalid operation at position 1"},
+		{"Missing operation", "12 3\n4  5\n*\n", "found more problems than the 1 operations"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Part2(t.Context(), strings.NewReader(tt.input))
+			if err == nil {
+				t.Fatalf("Part2() expected error containing %q", tt.expected)
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Part2() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 6)
+}
+
 func BenchmarkPart1(b *testing.B) {
 	data, err := os.ReadFile(getInputPath())
 	if err != nil {
@@ -126,7 +160,7 @@ func BenchmarkPart1(b *testing.B) {
 	b.Run("Part1", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part1(strings.NewReader(string(data)))
+			result, err := Part1(b.Context(), strings.NewReader(string(data)))
 			if err != nil {
 				b.Fatalf("Part1() error = %v", err)
 			}
@@ -146,7 +180,7 @@ func BenchmarkPart2(b *testing.B) {
 	b.Run("Part1", func(b *testing.B) {
 		b.ReportAllocs()
 		for b.Loop() {
-			result, err := Part2(strings.NewReader(string(data)))
+			result, err := Part2(b.Context(), strings.NewReader(string(data)))
 			if err != nil {
 				b.Fatalf("Part2() error = %v", err)
 			}
@@ -181,3 +215,7 @@ func worksheetsEqual(a, b Worksheet) bool {
 	}
 	return true
 }
+
+func getInputPath() string {
+	return filepath.Join("..", "..", "cmd", "6", "input.txt")
+}
@@ -0,0 +1,184 @@
+package day07
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sontanon/aoc-2025/internal/aoc"
+)
+
+type BeamSplitters struct {
+	StartingBeam int
+	Splits       [][]int
+	Width        int
+}
+
+func ParseInput(input io.Reader) (BeamSplitters, error) {
+	lineScanner := bufio.NewScanner(input)
+
+	result := BeamSplitters{}
+	numLine := 0
+
+	splits := make([][]int, 0)
+	for lineScanner.Scan() {
+		numLine++
+		if numLine%2 == 0 {
+			continue
+		}
+		line := lineScanner.Bytes()
+		if numLine == 1 {
+			result.Width = len(line)
+			if result.Width == 0 {
+				return BeamSplitters{}, errors.New("first line is empty")
+			}
+		}
+		if len(line) != result.Width {
+			return BeamSplitters{}, fmt.Errorf("unexpected line length of %d, expected %d", len(line), result.Width)
+		}
+		allocateSplits := true
+		for i, b := range line {
+			switch b {
+			case 'S':
+				result.StartingBeam = i
+			case '^':
+				if allocateSplits {
+					splits = append(splits, make([]int, 0))
+					allocateSplits = false
+				}
+				splits[len(splits)-1] = append(splits[len(splits)-1], i)
+			}
+		}
+	}
+	if err := lineScanner.Err(); err != nil {
+		return BeamSplitters{}, err
+	}
+	if result.StartingBeam == 0 {
+		return BeamSplitters{}, errors.New("invalid starting position or no starting position found")
+	}
+	result.Splits = splits
+	return result, nil
+}
+
+type Beams struct {
+	Positions map[int]struct{}
+	Width     int
+}
+
+func (b *Beams) Split(splitters []int) (int, error) {
+	if len(splitters) == 0 {
+		return 0, nil
+	}
+
+	count := 0
+	for _, splitterPos := range splitters {
+		if splitterPos+1 > b.Width || splitterPos-1 < 0 {
+			return 0, fmt.Errorf("invalid position %d would overflow bounds", splitterPos)
+		}
+		_, beamExists := b.Positions[splitterPos]
+		if !beamExists {
+			continue
+		}
+		count++
+		delete(b.Positions, splitterPos)
+		b.Positions[splitterPos+1] = struct{}{}
+		b.Positions[splitterPos-1] = struct{}{}
+	}
+	return count, nil
+}
+
+type Timelines struct {
+	Beams         map[int]int
+	BeamSplitters []map[int]struct{}
+	Width         int
+}
+
+func (t *Timelines) Advance() int {
+	for _, splitters := range t.BeamSplitters {
+		newBeams := make(map[int]int, len(t.Beams)*2)
+		for beamPos, beamValue := range t.Beams {
+			if _, isSplitter := splitters[beamPos]; isSplitter {
+				newBeams[beamPos-1] += beamValue
+				newBeams[beamPos+1] += beamValue
+			} else {
+				newBeams[beamPos] += beamValue
+			}
+		}
+		t.Beams = newBeams
+	}
+	total := 0
+	for _, v := range t.Beams {
+		total += v
+	}
+	return total
+}
+
+func Part1(ctx context.Context, input io.Reader) (int, error) {
+	end := aoc.StartPhase(ctx, aoc.PhaseParse)
+	bSps, err := ParseInput(input)
+	end()
+	if err != nil {
+		return 0, err
+	}
+
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	beams := Beams{
+		Positions: map[int]struct{}{
+			bSps.StartingBeam: {},
+		},
+		Width: bSps.Width,
+	}
+	count := 0
+	for _, splitters := range bSps.Splits {
+		new, err := beams.Split(splitters)
+		if err != nil {
+			return 0, err
+		}
+		count += new
+	}
+	return count, nil
+}
+
+func Part2(ctx context.Context, input io.Reader) (int, error) {
+	end := aoc.StartPhase(ctx, aoc.PhaseParse)
+	bSps, err := ParseInput(input)
+	end()
+	if err != nil {
+		return 0, err
+	}
+	end = aoc.StartPhase(ctx, aoc.PhasePrecompute)
+	timelines := Timelines{
+		Beams: map[int]int{
+			bSps.StartingBeam: 1,
+		},
+		BeamSplitters: make([]map[int]struct{}, 0, len(bSps.Splits)),
+		Width:         bSps.Width,
+	}
+	for _, splitters := range bSps.Splits {
+		splitterMap := make(map[int]struct{}, len(splitters))
+		for _, pos := range splitters {
+			splitterMap[pos] = struct{}{}
+		}
+		timelines.BeamSplitters = append(timelines.BeamSplitters, splitterMap)
+	}
+	end()
+	defer aoc.StartPhase(ctx, aoc.PhaseSolve)()
+	total := timelines.Advance()
+	return total, nil
+}
+
+type Solver struct{}
+
+func (Solver) Part1(ctx context.Context, input io.Reader) (int, error) {
+	return Part1(ctx, input)
+}
+
+func (Solver) Part2(ctx context.Context, input io.Reader) (int, error) {
+	return Part2(ctx, input)
+}
+
+func init() {
+	aoc.Register(aoc.Day{Number: 7, NewSolver: func() aoc.Solver { return Solver{} }})
+}
@@ -1,8 +1,10 @@
-package main
+package day07
 
 import (
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
 )
 
 func TestPart1(t *testing.T) {
@@ -38,6 +40,7 @@ func TestPart1(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part1(
+					t.Context(),
 					strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part1() error = %v", err)
@@ -82,6 +85,7 @@ func TestPart2(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part2(
+					t.Context(),
 					strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
@@ -92,3 +96,7 @@ func TestPart2(t *testing.T) {
 			})
 	}
 }
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 7)
+}
@@ -1,8 +1,10 @@
-package main
+package day08
 
 import (
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
 )
 
 func TestParseInput(t *testing.T) {
@@ -122,6 +124,7 @@ func TestPart1(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part1(
+					t.Context(),
 					strings.NewReader(tt.input), tt.numConnections)
 				if err != nil {
 					t.Fatalf("Part1() error = %v", err)
@@ -170,6 +173,7 @@ func TestPart2(t *testing.T) {
 			tt.name,
 			func(t *testing.T) {
 				result, err := Part2(
+					t.Context(),
 					strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
@@ -180,3 +184,7 @@ func TestPart2(t *testing.T) {
 			})
 	}
 }
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 8)
+}
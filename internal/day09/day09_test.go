@@ -1,8 +1,10 @@
-package main
+package day09
 
 import (
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
 )
 
 func TestPart1(t *testing.T) {
@@ -29,7 +31,7 @@ func TestPart1(t *testing.T) {
 		t.Run(
 			tt.name,
 			func(t *testing.T) {
-				result, err := Part1(strings.NewReader(tt.input))
+				result, err := Part1(t.Context(), strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part1() error = %v", err)
 				}
@@ -64,7 +66,7 @@ func TestPart2(t *testing.T) {
 		t.Run(
 			tt.name,
 			func(t *testing.T) {
-				result, err := Part2(strings.NewReader(tt.input))
+				result, err := Part2(t.Context(), strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
 				}
@@ -74,3 +76,7 @@ func TestPart2(t *testing.T) {
 			})
 	}
 }
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 9)
+}
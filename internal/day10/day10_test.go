@@ -1,8 +1,12 @@
-package main
+package day10
 
 import (
+	"context"
+	"io"
 	"strings"
 	"testing"
+
+	"github.com/sontanon/aoc-2025/internal/aoctest"
 )
 
 func TestPart1(t *testing.T) {
@@ -24,7 +28,7 @@ func TestPart1(t *testing.T) {
 		t.Run(
 			tt.name,
 			func(t *testing.T) {
-				result, err := Part1(strings.NewReader(tt.input))
+				result, err := Part1(t.Context(), strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part1() error = %v", err)
 				}
@@ -101,7 +105,7 @@ func TestPart2(t *testing.T) {
 		t.Run(
 			tt.name,
 			func(t *testing.T) {
-				result, err := Part2(strings.NewReader(tt.input))
+				result, err := Part2(t.Context(), strings.NewReader(tt.input))
 				if err != nil {
 					t.Fatalf("Part2() error = %v", err)
 				}
@@ -111,3 +115,36 @@ func TestPart2(t *testing.T) {
 			})
 	}
 }
+
+func TestPartErrors(t *testing.T) {
+	cancelled, cancel := context.WithCancel(t.Context())
+	cancel()
+	input := `[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}
+[...#.] (0,2,3,4) (2,3) (0,9) (0,1,2) (1,2,3,4) {7,5,12,7,2}
+`
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		fn       func(context.Context, io.Reader) (int, error)
+		expected string
+	}{
+		{"Part 1 button out of range", t.Context(), Part1, "action space on line 2"},
+		{"Part 2 button out of range", t.Context(), Part2, "action space on line 2"},
+		{"Cancelled context", cancelled, Part2, context.Canceled.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.fn(tt.ctx, strings.NewReader(input))
+			if err == nil {
+				t.Fatalf("expected error containing %q", tt.expected)
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	aoctest.VerifyAnswers(t, 10)
+}
@@ -0,0 +1,270 @@
+// Package workpool provides the fan-out/fan-in helpers shared by the days that
+// parallelise their solvers. Every helper stops all workers on the first error
+// or when the context is cancelled, and returns that error.
+//
+// Partial results are combined with reduce in no particular order, so reduce
+// must be associative and commutative with the zero value of R as identity.
+package workpool
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// DefaultWorkers selects runtime.GOMAXPROCS workers.
+const DefaultWorkers = 0
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+func Sum[N Number](a, b N) N {
+	return a + b
+}
+
+func Workers(n int) int {
+	if n > 0 {
+		return n
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// Items adapts a slice for MapReduceStream.
+func Items[T any](items []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Lines adapts a reader for MapReduceStream, yielding its lines without the
+// line terminator. Lines are not limited in length and only one is held in
+// memory at a time. Passing a *bufio.Reader continues from where it stands.
+func Lines(r io.Reader) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield("", err)
+				return
+			}
+			if line == "" && err != nil {
+				return
+			}
+			line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+			if !yield(line, nil) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Tokens adapts a reader for MapReduceStream, yielding the sep separated
+// tokens of r with surrounding whitespace removed. Empty tokens are skipped.
+func Tokens(r io.Reader, sep byte) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		br := bufio.NewReader(r)
+		for {
+			token, err := br.ReadString(sep)
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield("", err)
+				return
+			}
+			token = strings.TrimSpace(strings.TrimSuffix(token, string(sep)))
+			if token != "" && !yield(token, nil) {
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Line is a line of input with surrounding whitespace removed, along with its
+// 1-based number.
+type Line struct {
+	Number int
+	Text   string
+}
+
+// NumberedLines is Tokens for lines, keeping the number of every line so that
+// messages point at the input line past the blank lines skipped.
+func NumberedLines(r io.Reader) iter.Seq2[Line, error] {
+	return func(yield func(Line, error) bool) {
+		number := 0
+		for text, err := range Lines(r) {
+			if err != nil {
+				yield(Line{}, err)
+				return
+			}
+			number++
+			text = strings.TrimSpace(text)
+			if text != "" && !yield(Line{number, text}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// MapReduceChunks splits [0, n) into one contiguous chunk per worker.
+func MapReduceChunks[R any](
+	ctx context.Context,
+	n, workers int,
+	mapper func(ctx context.Context, start, end int) (R, error),
+	reduce func(a, b R) R,
+) (R, error) {
+	var total R
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	workers = min(Workers(workers), max(n, 1))
+	chunkSize := (n + workers - 1) / workers
+	results := make(chan R, workers)
+
+	wg := sync.WaitGroup{}
+	for start := 0; start < n; start += chunkSize {
+		end := min(start+chunkSize, n)
+		wg.Go(func() {
+			r, err := mapper(ctx, start, end)
+			if err != nil {
+				cancel(err)
+				return
+			}
+			results <- r
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for r := range results {
+		total = reduce(total, r)
+	}
+	if err := context.Cause(ctx); err != nil {
+		var zero R
+		return zero, err
+	}
+	return total, nil
+}
+
+func ForEachChunk(
+	ctx context.Context,
+	n, workers int,
+	fn func(ctx context.Context, start, end int) error,
+) error {
+	_, err := MapReduceChunks(ctx, n, workers, func(ctx context.Context, start, end int) (struct{}, error) {
+		return struct{}{}, fn(ctx, start, end)
+	}, func(a, _ struct{}) struct{} { return a })
+	return err
+}
+
+// MapReduce maps every item of a slice, i being its index, in chunked mode.
+func MapReduce[T, R any](
+	ctx context.Context,
+	items []T,
+	workers int,
+	mapper func(ctx context.Context, i int, item T) (R, error),
+	reduce func(a, b R) R,
+) (R, error) {
+	return MapReduceChunks(ctx, len(items), workers, func(ctx context.Context, start, end int) (R, error) {
+		var local R
+		for i := start; i < end; i++ {
+			if ctx.Err() != nil {
+				return local, context.Cause(ctx)
+			}
+			r, err := mapper(ctx, i, items[i])
+			if err != nil {
+				return local, err
+			}
+			local = reduce(local, r)
+		}
+		return local, nil
+	}, reduce)
+}
+
+// MapReduceStream hands items to the workers as seq yields them, i being the
+// position of the item in seq, so the input never has to be held in memory.
+// An error yielded by seq stops the pool like a mapper error.
+func MapReduceStream[T, R any](
+	ctx context.Context,
+	seq iter.Seq2[T, error],
+	workers int,
+	mapper func(ctx context.Context, i int, item T) (R, error),
+	reduce func(a, b R) R,
+) (R, error) {
+	var total R
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	type job struct {
+		i    int
+		item T
+	}
+	workers = Workers(workers)
+	jobs := make(chan job, workers)
+	results := make(chan R, workers)
+
+	producerDone := make(chan struct{})
+	go func() {
+		defer close(producerDone)
+		defer close(jobs)
+		i := 0
+		for item, err := range seq {
+			if err != nil {
+				cancel(err)
+				return
+			}
+			select {
+			case jobs <- job{i: i, item: item}:
+			case <-ctx.Done():
+				return
+			}
+			i++
+		}
+	}()
+
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Go(func() {
+			var local R
+			for j := range jobs {
+				if ctx.Err() != nil {
+					break
+				}
+				r, err := mapper(ctx, j.i, j.item)
+				if err != nil {
+					cancel(err)
+					break
+				}
+				local = reduce(local, r)
+			}
+			results <- local
+		})
+	}
+	go func() {
+		wg.Wait()
+		<-producerDone
+		close(results)
+	}()
+
+	for r := range results {
+		total = reduce(total, r)
+	}
+	if err := context.Cause(ctx); err != nil {
+		var zero R
+		return zero, err
+	}
+	return total, nil
+}
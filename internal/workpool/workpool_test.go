@@ -0,0 +1,257 @@
+package workpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestWorkers(t *testing.T) {
+	tests := []struct {
+		workers  int
+		expected int
+	}{
+		{DefaultWorkers, runtime.GOMAXPROCS(0)},
+		{-1, runtime.GOMAXPROCS(0)},
+		{3, 3},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.workers), func(t *testing.T) {
+			if result := Workers(tt.workers); result != tt.expected {
+				t.Errorf("Workers() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapReduceChunks(t *testing.T) {
+	tests := []struct {
+		n       int
+		workers int
+	}{
+		{0, 4},
+		{1, 4},
+		{10, 3},
+		{100, 8},
+		{7, 100},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d", tt.n, tt.workers), func(t *testing.T) {
+			covered := make([]int, tt.n)
+			result, err := MapReduceChunks(t.Context(), tt.n, tt.workers, func(_ context.Context, start, end int) (int, error) {
+				sum := 0
+				for i := start; i < end; i++ {
+					covered[i]++
+					sum += i
+				}
+				return sum, nil
+			}, Sum)
+			if err != nil {
+				t.Fatalf("MapReduceChunks() error = %v", err)
+			}
+			if expected := tt.n * (tt.n - 1) / 2; result != expected {
+				t.Errorf("MapReduceChunks() = %v, want %v", result, expected)
+			}
+			for i, c := range covered {
+				if c != 1 {
+					t.Errorf("index %d mapped %d times, want 1", i, c)
+				}
+			}
+		})
+	}
+}
+
+func TestMapReduce(t *testing.T) {
+	items := make([]int, 1_000)
+	for i := range items {
+		items[i] = i + 1
+	}
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name          string
+		mapper        func(ctx context.Context, i int, item int) (int, error)
+		expected      int
+		expectedError error
+	}{
+		{
+			"Sum of squares",
+			func(_ context.Context, _ int, item int) (int, error) {
+				return item * item, nil
+			},
+			333_833_500,
+			nil,
+		},
+		{
+			"Mapper error",
+			func(_ context.Context, i int, item int) (int, error) {
+				if i == 500 {
+					return 0, errBoom
+				}
+				return item, nil
+			},
+			0,
+			errBoom,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapReduce(t.Context(), items, 4, tt.mapper, Sum)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("MapReduce() error = %v, want %v", err, tt.expectedError)
+			}
+			if result != tt.expected {
+				t.Errorf("MapReduce() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapReduceStream(t *testing.T) {
+	errBoom := errors.New("boom")
+	errSource := errors.New("source")
+	numbers := func(n int, failAt int) iter.Seq2[int, error] {
+		return func(yield func(int, error) bool) {
+			for i := range n {
+				if i == failAt {
+					yield(0, errSource)
+					return
+				}
+				if !yield(i, nil) {
+					return
+				}
+			}
+		}
+	}
+	tests := []struct {
+		name          string
+		seq           iter.Seq2[int, error]
+		failMapperAt  int
+		expected      int
+		expectedError error
+	}{
+		{"Sum", numbers(10_000, -1), -1, 49_995_000, nil},
+		{"Empty", numbers(0, -1), -1, 0, nil},
+		{"Source error", numbers(10_000, 5_000), -1, 0, errSource},
+		{"Mapper error", numbers(10_000, -1), 5_000, 0, errBoom},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapReduceStream(t.Context(), tt.seq, 4, func(_ context.Context, i int, item int) (int, error) {
+				if i != item {
+					return 0, fmt.Errorf("index %d does not match item %d", i, item)
+				}
+				if i == tt.failMapperAt {
+					return 0, errBoom
+				}
+				return item, nil
+			}, Sum)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("MapReduceStream() error = %v, want %v", err, tt.expectedError)
+			}
+			if result != tt.expected {
+				t.Errorf("MapReduceStream() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Empty", "", nil},
+		{"Trailing newline", "a\nb\n", []string{"a", "b"}},
+		{"No trailing newline", "a\nb", []string{"a", "b"}},
+		{"Blank and CRLF lines", " a \r\n\r\nb\n\n", []string{" a ", "", "b", ""}},
+		{"Long line", strings.Repeat("x", 100_000), []string{strings.Repeat("x", 100_000)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := collect(Lines(strings.NewReader(tt.input)))
+			if err != nil {
+				t.Fatalf("Lines() error = %v", err)
+			}
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("Lines() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		sep      byte
+		expected []string
+	}{
+		{"Empty", "", ',', nil},
+		{"Commas", "1-2,3-4, 5-6\n", ',', []string{"1-2", "3-4", "5-6"}},
+		{"Empty tokens", ",1,,2,", ',', []string{"1", "2"}},
+		{"Lines", "12\n\n 34 \r\n56", '\n', []string{"12", "34", "56"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := collect(Tokens(strings.NewReader(tt.input), tt.sep))
+			if err != nil {
+				t.Fatalf("Tokens() error = %v", err)
+			}
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("Tokens() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberedLines(t *testing.T) {
+	var result []Line
+	for line, err := range NumberedLines(strings.NewReader("12\n\n 34 \r\n\n\n56\n")) {
+		if err != nil {
+			t.Fatalf("NumberedLines() error = %v", err)
+		}
+		result = append(result, line)
+	}
+	expected := []Line{{1, "12"}, {3, "34"}, {6, "56"}}
+	if !slices.Equal(result, expected) {
+		t.Errorf("NumberedLines() = %v, want %v", result, expected)
+	}
+}
+
+func TestLinesReadError(t *testing.T) {
+	errRead := errors.New("read")
+	_, err := collect(Lines(io.MultiReader(strings.NewReader("a\n"), iotest.ErrReader(errRead))))
+	if !errors.Is(err, errRead) {
+		t.Errorf("Lines() error = %v, want %v", err, errRead)
+	}
+}
+
+func collect(seq iter.Seq2[string, error]) ([]string, error) {
+	var result []string
+	for s, err := range seq {
+		if err != nil {
+			return result, err
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func TestCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err := MapReduce(ctx, []int{1, 2, 3}, 2, func(_ context.Context, _ int, item int) (int, error) {
+		return item, nil
+	}, Sum)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("MapReduce() error = %v, want %v", err, context.Canceled)
+	}
+}